@@ -0,0 +1,49 @@
+package base62
+
+import (
+	"fmt"
+)
+
+// Alignment sets which side of the minimum width encoded integers are
+// aligned to when padded
+type Alignment int
+
+const (
+	// AlignRight left pads encoded integers, the default
+	AlignRight Alignment = iota
+
+	// AlignLeft right pads encoded integers, for fixed-width left aligned
+	// fields such as those of legacy record formats
+	AlignLeft
+)
+
+// String returns the name of the alignment, as used in specs
+func (a Alignment) String() string {
+	switch a {
+	case AlignRight:
+		return "right"
+	case AlignLeft:
+		return "left"
+	}
+	return fmt.Sprintf("Alignment(%d)", int(a))
+}
+
+// Align sets the alignment of padded integers. Right padding requires a
+// PaddingChar outside of the alphabet, as trailing zero digits would change
+// the value, so without one values remain left padded with zeros
+func Align(a Alignment) option {
+	return func(e *Encoding) {
+		e.align = a
+	}
+}
+
+// parseAlignment returns the alignment with the given name
+func parseAlignment(name string) (Alignment, error) {
+	switch name {
+	case "", "right":
+		return AlignRight, nil
+	case "left":
+		return AlignLeft, nil
+	}
+	return 0, ErrInvalidConfig{fmt.Errorf("Alignment %q must be left or right", name)}
+}
@@ -0,0 +1,72 @@
+package base62
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlignLeft(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(8), PaddingChar(' '), Align(AlignLeft))
+
+	testcases := []struct {
+		num     int64
+		encoded string
+	}{
+		{0, "0       "},
+		{72, "1A      "},
+		{4815162342, "5Frvgk  "},
+		{1<<53 + 1, "fFgnDxSe9"},
+	}
+
+	for _, tc := range testcases {
+		v := e.EncodeInt64(tc.num)
+		t.Logf("Encoded %d as %q", tc.num, v)
+		assert.Equal(t, tc.encoded, v)
+		assert.Equal(t, tc.encoded, string(e.AppendInt64(nil, tc.num)))
+
+		n, err := e.DecodeToInt64(v)
+		require.NoError(t, err)
+		assert.Equal(t, tc.num, n)
+	}
+
+	// Arithmetic preserves the alignment
+	v, err := e.Next("1z      ")
+	require.NoError(t, err)
+	assert.Equal(t, "20      ", v)
+
+	// Signed values keep the marker with the digits
+	assert.Equal(t, "-5      ", e.Clone().Option(Sign('-')).EncodeInt64(-5))
+}
+
+func TestAlignLeftColumn(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(4), PaddingChar('_'), Align(AlignLeft))
+	vals := []uint64{1, 4815162342}
+
+	data, offsets, err := e.EncodeUint64Column(vals)
+	require.NoError(t, err)
+	assert.Equal(t, "1___5Frvgk", string(data))
+
+	v, err := e.DecodeUint64Column(data, offsets)
+	require.NoError(t, err)
+	assert.Equal(t, vals, v)
+}
+
+func TestAlignLeftWithoutPaddingChar(t *testing.T) {
+	// Trailing zeros would change the value, so zeros still pad on the left
+	e := NewStdEncoding().Option(Padding(4), Align(AlignLeft))
+	assert.Equal(t, "001A", e.EncodeInt64(72))
+}
+
+func TestAlignmentString(t *testing.T) {
+	assert.Equal(t, "right", AlignRight.String())
+	assert.Equal(t, "left", AlignLeft.String())
+	assert.Equal(t, "Alignment(5)", fmt.Sprint(Alignment(5)))
+
+	e := NewStdEncoding().Option(Padding(8), PaddingChar(' '), Align(AlignLeft))
+	assert.Equal(t,
+		`base62.NewEncoding("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz").Option(base62.Padding(8), base62.PaddingChar(' '), base62.Align(base62.AlignLeft))`,
+		fmt.Sprintf("%#v", e))
+}
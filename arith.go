@@ -0,0 +1,210 @@
+package base62
+
+import (
+	"fmt"
+	"math"
+	"unicode/utf8"
+)
+
+// Next returns the base62 encoding of the value following s using the
+// StdEncoding, see Encoding.Next
+func Next(s string) (string, error) {
+	return StdEncoding.Next(s)
+}
+
+// Next returns the base62 encoding of the value following s, ie. s + 1,
+// computed directly on the encoded digits. The width of s is preserved,
+// so padded input returns padded output, growing only when the carry
+// overflows the most significant digit. Negative values count up towards
+// zero by the rules of Add
+func (e *Encoding) Next(s string) (string, error) {
+	return e.Add(s, 1)
+}
+
+// Add returns the base62 encoding of s + delta using the StdEncoding,
+// see Encoding.Add
+func Add(s string, delta int64) (string, error) {
+	return StdEncoding.Add(s, delta)
+}
+
+// Sub returns the base62 encoding of s - delta using the StdEncoding,
+// see Encoding.Sub
+func Sub(s string, delta int64) (string, error) {
+	return StdEncoding.Sub(s, delta)
+}
+
+// Add returns the base62 encoding of s + delta. Where s is a non-negative
+// value encoded as its plain digits, the sum is computed on the digits and
+// the width of s is preserved as with Next. Negative values and sums, and
+// values of encodings which permute, mask or otherwise alter their digits,
+// are decoded and the sum encoded by EncodeInt64. An ErrOverflow is
+// returned if the sum is negative and the encoding can't sign it
+func (e *Encoding) Add(s string, delta int64) (string, error) {
+	if !e.digitArithmetic(s) {
+		return e.addValue(s, delta, false)
+	}
+	if delta < 0 {
+		return e.sub(s, magnitude(delta))
+	}
+	return e.add(s, uint64(delta))
+}
+
+// Sub returns the base62 encoding of s - delta, as with Add
+func (e *Encoding) Sub(s string, delta int64) (string, error) {
+	if !e.digitArithmetic(s) {
+		return e.addValue(s, delta, true)
+	}
+	if delta < 0 {
+		return e.add(s, magnitude(delta))
+	}
+	return e.sub(s, uint64(delta))
+}
+
+func (e *Encoding) add(s string, m uint64) (string, error) {
+	d, err := e.digits(e.unpad(s))
+	if err != nil {
+		return "", err
+	}
+
+	d = addDigits(d, m)
+	if !fitsInt64(d) {
+		return "", ErrOverflow{fmt.Errorf("Adding %d to %s overflows int64", m, s)}
+	}
+
+	return e.fromDigits(d), nil
+}
+
+func (e *Encoding) sub(s string, m uint64) (string, error) {
+	d, err := e.digits(e.unpad(s))
+	if err != nil {
+		return "", err
+	}
+
+	d, ok := subDigits(d, m)
+	if !ok {
+		if !e.signed() {
+			return "", ErrOverflow{fmt.Errorf("Subtracting %d from %s would be negative", m, s)}
+		}
+
+		// The result is negative, its magnitude m less the value of s,
+		// which wraps to math.MinInt64 for a magnitude of 2^63
+		n, _, _ := e.parseUint64(e.unpad(s))
+		return e.EncodeInt64(-int64(m - n)), nil
+	}
+
+	return e.fromDigits(d), nil
+}
+
+// digitArithmetic reports whether Add and Sub may work on the digits of s,
+// which must be a plain non-negative value
+func (e *Encoding) digitArithmetic(s string) bool {
+	return e.inStep() && !e.zigzag && !e.hasSign(s)
+}
+
+// addValue adds or subtracts delta for encodings where the encoded digits
+// don't move in step with the value, so it is decoded and re-encoded
+// instead, with overflow checked against the int64 range
+func (e *Encoding) addValue(s string, delta int64, sub bool) (string, error) {
+	n, err := e.DecodeToInt64(s)
+	if err != nil {
+		return "", err
+	}
+
+	var r int64
+	if sub {
+		r = n - delta
+		if (delta > 0 && r > n) || (delta < 0 && r < n) {
+			return "", ErrOverflow{fmt.Errorf("Subtracting %d from %s overflows int64", delta, s)}
+		}
+	} else {
+		r = n + delta
+		if (delta > 0 && r < n) || (delta < 0 && r > n) {
+			return "", ErrOverflow{fmt.Errorf("Adding %d to %s overflows int64", delta, s)}
+		}
+	}
+
+	if !e.zigzag || !e.inStep() {
+		return e.EncodeInt64(r), nil
+	}
+
+	// Preserve the width of the original encoding
+	return e.padValue(e.encodeInt64(r), utf8.RuneCountInString(s)), nil
+}
+
+// magnitude returns the absolute value of a negative n, which unlike
+// -n cannot overflow for math.MinInt64
+func magnitude(n int64) uint64 {
+	return uint64(-(n + 1)) + 1
+}
+
+// addDigits adds m to the digits in place, carrying upwards and
+// prepending additional digits if the carry overflows the width
+func addDigits(d []byte, m uint64) []byte {
+	var carry = m
+
+	for i := len(d) - 1; i >= 0 && carry > 0; i-- {
+		sum := uint64(d[i]) + carry%base
+		carry /= base
+		if sum >= base {
+			sum -= base
+			carry++
+		}
+		d[i] = byte(sum)
+	}
+
+	for carry > 0 {
+		d = append([]byte{byte(carry % base)}, d...)
+		carry /= base
+	}
+
+	return d
+}
+
+// fitsInt64 returns whether the value of the digits is within the int64
+// range, so that it can be decoded
+func fitsInt64(d []byte) bool {
+	var n uint64
+	for _, v := range d {
+		if n > (math.MaxInt64-uint64(v))/base {
+			return false
+		}
+		n = n*base + uint64(v)
+	}
+	return true
+}
+
+// subDigits subtracts m from the digits in place, borrowing from higher
+// digits, returns false if the result would be negative
+func subDigits(d []byte, m uint64) ([]byte, bool) {
+	var borrow = m
+
+	for i := len(d) - 1; i >= 0 && borrow > 0; i-- {
+		sub := byte(borrow % base)
+		borrow /= base
+		if d[i] < sub {
+			d[i] += base - sub
+			borrow++
+		} else {
+			d[i] -= sub
+		}
+	}
+
+	return d, borrow == 0
+}
+
+// fromDigits returns the string for a slice of alphabet indexes,
+// padded to the minimum length of the encoding
+func (e *Encoding) fromDigits(d []byte) string {
+	b := make([]byte, len(d))
+	for i, v := range d {
+		b[i] = e.encode[v]
+	}
+
+	s := string(b)
+	if s == "" {
+		s = e.zero()
+	}
+	s = e.padValue(s, e.padding)
+
+	return s
+}
@@ -0,0 +1,303 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNext(t *testing.T) {
+	testcases := []struct {
+		s    string
+		next string
+	}{
+		{"", "1"},
+		{"0", "1"},
+		{"9", "A"},
+		{"z", "10"},
+		{"0z", "10"},
+		{"zz", "100"},
+		{"000z", "0010"},
+		{"5Frvgk", "5Frvgl"},
+		{"000005Frvgk", "000005Frvgl"},
+		{"AzL8n0Y58m6", "AzL8n0Y58m7"},
+	}
+
+	for _, tc := range testcases {
+		v, err := Next(tc.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Next of %q is %q", tc.s, v)
+		assert.Equal(t, tc.next, v)
+	}
+}
+
+func TestNextMatchesEncode(t *testing.T) {
+	s := ""
+	for i := int64(1); i <= 10000; i++ {
+		var err error
+		s, err = Next(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, EncodeInt64(i), s)
+	}
+}
+
+func TestNextNegative(t *testing.T) {
+	testcases := []struct {
+		e    *Encoding
+		s    string
+		next string
+	}{
+		{StdEncoding, "-1", "0"},
+		{StdEncoding, "-2", "-1"},
+		{StdEncoding, "-10", "-z"},
+		{StdEncoding, "-AzL8n0Y58m8", "-AzL8n0Y58m7"},
+		{NewStdEncoding().Option(Sign('~')), "~1", "0"},
+	}
+
+	for _, tc := range testcases {
+		v, err := tc.e.Next(tc.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Next of %q is %q", tc.s, v)
+		assert.Equal(t, tc.next, v)
+	}
+
+	// Counting up through zero matches encoding
+	s := EncodeInt64(-200)
+	for i := int64(-199); i <= 200; i++ {
+		var err error
+		s, err = Next(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, EncodeInt64(i), s)
+	}
+}
+
+func TestNextPadded(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(4))
+
+	v, err := e.Next("z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "0010", v)
+}
+
+func TestNextInvalid(t *testing.T) {
+	_, err := Next("1-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestAddSub(t *testing.T) {
+	testcases := []struct {
+		s      string
+		delta  int64
+		result string
+	}{
+		{"", 0, "0"},
+		{"", 1, "1"},
+		{"0", 61, "z"},
+		{"1", 61, "10"},
+		{"000z", 1, "0010"},
+		{"10", -1, "0z"},
+		{"5Frvgk", 1000, "5Frvws"},
+		{"", 9223372036854775807, "AzL8n0Y58m7"},
+		{"AzL8n0Y58m7", -9223372036854775807, "00000000000"},
+	}
+
+	for _, tc := range testcases {
+		v, err := Add(tc.s, tc.delta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Added %d to %q as %q", tc.delta, tc.s, v)
+		assert.Equal(t, tc.result, v)
+
+		v, err = Sub(tc.s, -tc.delta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.result, v)
+	}
+}
+
+func TestAddMatchesEncode(t *testing.T) {
+	for _, a := range testcases {
+		for _, b := range testcases {
+			if a.num > 9223372036854775807-b.num {
+				continue
+			}
+
+			v, err := Add(a.encoded, b.num)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, EncodeInt64(a.num+b.num), v)
+
+			if a.num >= b.num {
+				v, err = Sub(a.encoded, b.num)
+				if err != nil {
+					t.Fatal(err)
+				}
+				n, err := DecodeToInt64(v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, a.num-b.num, n)
+			}
+		}
+	}
+}
+
+func TestSubOverflow(t *testing.T) {
+	_, err := Sub("AzL8n0Y58m8", -9223372036854775808)
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = Sub("-AzL8n0Y58m7", 2)
+	assert.IsType(t, ErrOverflow{}, err)
+}
+
+func TestAddSigned(t *testing.T) {
+	testcases := []struct {
+		s      string
+		delta  int64
+		result string
+	}{
+		{"-5", 3, "-2"},
+		{"-5", 5, "0"},
+		{"-5", 10, "5"},
+		{"5", -10, "-5"},
+		{"10", -63, "-1"},
+		{"", -1, "-1"},
+		{"-1", -1, "-2"},
+		{"AzL8n0Y58m7", -9223372036854775808, "-1"},
+		{"0", -9223372036854775808, "-AzL8n0Y58m8"},
+	}
+
+	for _, tc := range testcases {
+		v, err := Add(tc.s, tc.delta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Added %d to %q as %q", tc.delta, tc.s, v)
+		assert.Equal(t, tc.result, v)
+
+		if tc.delta != -9223372036854775808 {
+			v, err = Sub(tc.s, -tc.delta)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.result, v)
+		}
+	}
+}
+
+func TestAddOverflow(t *testing.T) {
+	v, err := Add("AzL8n0Y58m6", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "AzL8n0Y58m7", v)
+
+	n, err := DecodeToInt64(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(9223372036854775807), n)
+
+	_, err = Add("AzL8n0Y58m7", 1)
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = Next("AzL8n0Y58m7")
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = Add("1", 9223372036854775807)
+	assert.IsType(t, ErrOverflow{}, err)
+
+	e := NewStdEncoding().Option(Padding(12))
+	_, err = e.Next("0AzL8n0Y58m7")
+	assert.IsType(t, ErrOverflow{}, err)
+}
+
+func TestNextPaddingChar(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(4), PaddingChar('_'))
+
+	v, err := e.Next("__1z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "__20", v)
+
+	c, err := e.Compare("___z", "__10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, -1, c)
+}
+
+func TestAddOptions(t *testing.T) {
+	encodings := []*Encoding{
+		NewStdEncoding().Option(WithMask(0x5f3759df)),
+		NewStdEncoding().Option(KnuthHash()),
+		NewStdEncoding().Option(Luhn()),
+		NewStdEncoding().Option(Sortable()),
+		NewStdEncoding().Option(Group(2, "-"), Padding(6)),
+		NewStdEncoding().Option(LetterFirst()),
+		NewStdEncoding().Option(Strict(), WithMask(0xff)),
+	}
+
+	for _, e := range encodings {
+		t.Logf("Encoding %v", e)
+
+		for _, n := range []int64{0, 5, 61, 62, 3843, 1 << 40} {
+			v, err := e.Next(e.EncodeInt64(n))
+			if err != nil {
+				t.Fatal(err)
+			}
+			m, err := e.DecodeToInt64(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, n+1, m)
+			assert.Equal(t, e.EncodeInt64(n+1), v)
+
+			v, err = e.Add(e.EncodeInt64(n), 100)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, e.EncodeInt64(n+100), v)
+
+			v, err = e.Sub(e.EncodeInt64(n+100), 100)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, e.EncodeInt64(n), v)
+		}
+	}
+
+	e := NewStdEncoding().Option(WithMask(0xff))
+	v, err := e.Sub(e.EncodeInt64(5), 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, e.EncodeInt64(-1), v)
+
+	e = NewStdEncoding().Option(Sign('~'))
+	v, err = e.Sub("5", 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "~1", v)
+
+	v, err = e.Add("~1", 63)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "10", v)
+}
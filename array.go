@@ -0,0 +1,124 @@
+package base62
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/mattheath/base62/core"
+)
+
+// ByteArray is the set of fixed size byte arrays supported by the array
+// codec, covering common identifiers and digests such as UUIDs (16 bytes),
+// SHA-1 (20 bytes) and SHA-256 (32 bytes)
+type ByteArray interface {
+	~[4]byte | ~[8]byte | ~[12]byte | ~[16]byte | ~[20]byte | ~[24]byte |
+		~[28]byte | ~[32]byte | ~[48]byte | ~[64]byte
+}
+
+// EncodeArray returns the fixed width base62 encoding of a byte array using
+// the StdEncoding, see EncodeArrayWith
+func EncodeArray[A ByteArray](a A) string {
+	return EncodeArrayWith(StdEncoding, a)
+}
+
+// DecodeArray decodes a fixed width base62 encoded byte array using the
+// StdEncoding, see DecodeArrayWith
+func DecodeArray[A ByteArray](s string) (A, error) {
+	return DecodeArrayWith[A](StdEncoding, s)
+}
+
+// EncodeArrayWith returns the encoding of a byte array with the Codec c.
+// For an Encoding the array is encoded as with EncodeBytes, so arrays of up
+// to BlockSize bytes are interpreted as an unsigned integer in its
+// ByteOrder. The output is always left padded with the zero digit to the
+// width needed for the largest value of the array size, so every array of a
+// given size has the same encoded length and leading zero bytes are preserved
+func EncodeArrayWith[A ByteArray](c Codec, a A) string {
+	return c.EncodeBytes(reflect.ValueOf(&a).Elem().Bytes())
+}
+
+// DecodeArrayWith decodes an encoded byte array with the Codec c, the
+// input must be exactly the width produced by EncodeArrayWith
+func DecodeArrayWith[A ByteArray](c Codec, s string) (A, error) {
+	var a A
+	b := reflect.ValueOf(&a).Elem().Bytes()
+
+	// Decode in place where the codec is an Encoding without transforms
+	if e, ok := c.(*Encoding); ok && e.transforms == nil {
+		err := e.decodeBlocks(b, s)
+		return a, err
+	}
+
+	v, err := c.DecodeBytes(s)
+	if err != nil {
+		return a, err
+	}
+	if len(v) != len(b) {
+		return a, ErrInvalidLength{fmt.Errorf("Decoded %d bytes, expected %d", len(v), len(b))}
+	}
+	copy(b, v)
+
+	return a, nil
+}
+
+// encodeFixed returns the fixed width encoding of b, which is
+// interpreted as an unsigned integer in the ByteOrder of the encoding
+func (e *Encoding) encodeFixed(b []byte) string {
+	out := make([]byte, core.EncodedLen(len(b)))
+	e.putFixed(out, b)
+	return string(out)
+}
+
+// putFixed writes the fixed width encoding of b to out,
+// which must be exactly the encoded width
+func (e *Encoding) putFixed(out, b []byte) {
+	if e.littleEndian {
+		b = slices.Clone(b)
+		slices.Reverse(b)
+	}
+	e.raw.AppendBytes(out[:0], b)
+}
+
+// decodeFixed decodes a fixed width encoding into b, which must be the
+// same length as the bytes originally encoded
+func (e *Encoding) decodeFixed(s string, b []byte) error {
+	if w := core.EncodedLen(len(b)); len(s) != w {
+		return ErrInvalidLength{fmt.Errorf("Encoded %d bytes must be %d characters, got %d", len(b), w, len(s))}
+	}
+
+	d, err := e.digits(s)
+	if err != nil {
+		return err
+	}
+
+	if !e.decodeDigits(b, d) {
+		return ErrOverflow{fmt.Errorf("Value of %s overflows %d bytes", s, len(b))}
+	}
+
+	if e.littleEndian {
+		slices.Reverse(b)
+	}
+	return nil
+}
+
+// decodeDigits decodes the digit values d, as returned by digits, into the
+// big-endian unsigned integer b, returning false if it overflows b
+func (e *Encoding) decodeDigits(b, d []byte) bool {
+	w := core.EncodedLen(len(b))
+	if len(d) > w {
+		return false
+	}
+
+	// Left pad the canonical characters of the digits to the full width,
+	// as any remapped characters have already been resolved
+	src := make([]byte, w)
+	for i := range src {
+		src[i] = e.encode[0]
+	}
+	for i, v := range d {
+		src[w-len(d)+i] = e.encode[v]
+	}
+
+	return e.raw.DecodeBytes(b, src)
+}
@@ -0,0 +1,83 @@
+package base62
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeArray16(t *testing.T) {
+	testcases := []struct {
+		array   [16]byte
+		encoded string
+	}{
+		{[16]byte{}, "0000000000000000000000"},
+		{[16]byte{15: 1}, "0000000000000000000001"},
+		{[16]byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "3tX16dB2jpss4tZORYcqo3"},
+		{[16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "7n42DGM5Tflk9n8mt7Fhc7"},
+	}
+
+	for _, tc := range testcases {
+		v := EncodeArray(tc.array)
+		t.Logf("Encoded %x as %s", tc.array, v)
+		assert.Equal(t, tc.encoded, v)
+
+		a, err := DecodeArray[[16]byte](v)
+		require.NoError(t, err)
+		assert.Equal(t, tc.array, a)
+	}
+}
+
+func TestEncodeArrayDigests(t *testing.T) {
+	s1 := sha1.Sum([]byte("base62"))
+	v := EncodeArray(s1)
+	assert.Len(t, v, 27)
+
+	a1, err := DecodeArray[[sha1.Size]byte](v)
+	require.NoError(t, err)
+	assert.Equal(t, s1, a1)
+
+	s256 := sha256.Sum256([]byte("base62"))
+	v = EncodeArray(s256)
+	assert.Len(t, v, 43)
+
+	a256, err := DecodeArray[[sha256.Size]byte](v)
+	require.NoError(t, err)
+	assert.Equal(t, s256, a256)
+}
+
+type testUUID [16]byte
+
+func TestEncodeArrayNamedType(t *testing.T) {
+	u := testUUID{0xde, 0xad, 0xbe, 0xef}
+
+	v := EncodeArray(u)
+	a, err := DecodeArray[testUUID](v)
+	require.NoError(t, err)
+	assert.Equal(t, u, a)
+}
+
+func TestEncodeArrayWithEncoding(t *testing.T) {
+	e := NewEncoding("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
+
+	v := EncodeArrayWith(e, [4]byte{0, 0, 0, 1})
+	assert.Equal(t, "aaaaab", v)
+
+	a, err := DecodeArrayWith[[4]byte](e, v)
+	require.NoError(t, err)
+	assert.Equal(t, [4]byte{0, 0, 0, 1}, a)
+}
+
+func TestDecodeArrayInvalid(t *testing.T) {
+	_, err := DecodeArray[[16]byte]("7n42DGM5Tflk9n8mt7Fhc")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeArray[[16]byte]("7n42DGM5Tflk9n8mt7Fhc8")
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = DecodeArray[[16]byte]("7n42DGM5Tflk9n8mt7Fhc-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
@@ -2,22 +2,105 @@
 package base62
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"math"
-	"math/big"
 	"strconv"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/mattheath/base62/core"
 )
 
 const base = 62
 
+// MaxLenInt64 is the maximum length of an unpadded encoded int64
+const MaxLenInt64 = 11
+
+// Encoding is a base62 encoding of a 62 character alphabet and its options.
+// Its fields are all comparable, holding lists as strings and functions by
+// pointer, so that Equal can compare encodings as values
 type Encoding struct {
 	encode  string
+	decode  [256]byte
 	padding int
+	zigzag  bool
+
+	// raw encodes and decodes the plain digits of the alphabet, on which
+	// the options of the encoding are built
+	raw core.Codec
+
+	// littleEndian interprets byte values as little-endian integers
+	littleEndian bool
+
+	// groupSize and groupSep format encoded values for display
+	groupSize int
+	groupSep  string
+
+	// ignore are separator characters removed before decoding
+	ignore string
+
+	// lenient removes whitespace and hyphens before decoding
+	lenient bool
+
+	// sign marks negative int64 values, unless zero
+	sign byte
+
+	// emptyZero encodes zero as an empty string, as in earlier versions
+	emptyZero bool
+
+	// padChar pads encoded integers in place of the zero digit, if set
+	padChar rune
+
+	// align sets which side padding characters are added to
+	align Alignment
+
+	// maxDecodeLen limits the length of strings decoded, if positive
+	maxDecodeLen int
+
+	// luhn appends and verifies a Luhn mod N check character
+	luhn bool
+
+	// strict decoding accepts only the canonical encoding of each value
+	strict bool
+
+	// caseInsensitive letters have their other case added to the decode table
+	caseInsensitive bool
+
+	// remap are pairs of characters added to the decode table
+	remap string
+
+	// transforms are applied to bytes around encoding and decoding
+	transforms *pipeline
+
+	// blocklist are words screened from generated IDs, separated by blocklistSep
+	blocklist string
+
+	// permutation maps int64 values around encoding and decoding
+	permutation *permuter
+
+	// mask is XORed with integer values around encoding and decoding
+	mask uint64
+
+	// letterFirst prefixes the zero digit to integers starting with a digit
+	letterFirst bool
+
+	// sortable encodes integers at a fixed width in the order of values
+	sortable bool
+
+	// immutable encodings are copied rather than modified by Option
+	immutable bool
 }
 
-// Option sets a number of optional parameters on the encoding
+// Option sets a number of optional parameters on the encoding. The
+// standard encodings are immutable, so for these a configured copy is
+// returned instead and the original is left unchanged
 func (e *Encoding) Option(opts ...option) *Encoding {
+	if e.immutable {
+		e = e.Clone()
+	}
+
 	for _, opt := range opts {
 		opt(e)
 	}
@@ -26,35 +109,314 @@ func (e *Encoding) Option(opts ...option) *Encoding {
 	return e
 }
 
+// Clone returns a copy of the encoding, which may be configured with
+// further options without affecting the original
+func (e *Encoding) Clone() *Encoding {
+	c := *e
+	c.immutable = false
+	return &c
+}
+
+// WithOptions returns a copy of the encoding configured with opts, leaving
+// the original unchanged, so shared encodings can be safely derived from
+func (e *Encoding) WithOptions(opts ...option) *Encoding {
+	return e.Clone().Option(opts...)
+}
+
+// freeze makes the encoding immutable, for the standard encodings
+func (e *Encoding) freeze() *Encoding {
+	e.immutable = true
+	return e
+}
+
+// Equal returns whether two encodings are configured identically, and so
+// produce and accept exactly the same encoded values
+func (e *Encoding) Equal(other *Encoding) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+
+	a, b := *e, *other
+	a.immutable, b.immutable = false, false
+	return a == b
+}
+
+// Alphabet returns the characters of the encoding, in order of value
+func (e *Encoding) Alphabet() string {
+	return e.encode
+}
+
+// Padding returns the minimum length of encoded strings, zero if unpadded
+func (e *Encoding) Padding() int {
+	return e.padding
+}
+
+// String describes the configuration of the encoding
+func (e *Encoding) String() string {
+	s := fmt.Sprintf("base62.Encoding{alphabet: %q, padding: %d", e.encode, e.padding)
+	if e.zigzag {
+		s += ", zigzag"
+	}
+	if e.littleEndian {
+		s += ", little-endian"
+	}
+	if e.groupSize > 0 {
+		s += fmt.Sprintf(", group: %d %q", e.groupSize, e.groupSep)
+	}
+	if e.ignore != "" {
+		s += fmt.Sprintf(", ignore: %q", e.ignore)
+	}
+	if e.lenient {
+		s += ", lenient"
+	}
+	if e.sign != 0 {
+		s += fmt.Sprintf(", sign: %q", e.sign)
+	}
+	if e.emptyZero {
+		s += ", empty zero"
+	}
+	if e.padChar != 0 {
+		s += fmt.Sprintf(", padding char: %q", e.padChar)
+	}
+	if e.align != AlignRight {
+		s += ", align: " + e.align.String()
+	}
+	if e.maxDecodeLen > 0 {
+		s += fmt.Sprintf(", max decode length: %d", e.maxDecodeLen)
+	}
+	if e.luhn {
+		s += ", luhn"
+	}
+	if e.strict {
+		s += ", strict"
+	}
+	if e.caseInsensitive {
+		s += ", case insensitive"
+	}
+	if e.remap != "" {
+		s += fmt.Sprintf(", remap: %q", e.remap)
+	}
+	if e.blocklist != "" {
+		s += fmt.Sprintf(", blocklist: %d words", strings.Count(e.blocklist, blocklistSep)+1)
+	}
+	if e.transforms != nil {
+		s += fmt.Sprintf(", transforms: %d", len(e.transforms.stages))
+	}
+	if e.permutation != nil {
+		s += ", permuted"
+	}
+	if e.mask != 0 {
+		s += fmt.Sprintf(", mask: %#x", e.mask)
+	}
+	if e.letterFirst {
+		s += ", letter first"
+	}
+	if e.sortable {
+		s += ", sortable"
+	}
+	return s + "}"
+}
+
+// GoString returns the Go syntax to construct the encoding
+func (e *Encoding) GoString() string {
+	var opts []string
+	if e.padding > 0 {
+		opts = append(opts, fmt.Sprintf("base62.Padding(%d)", e.padding))
+	}
+	if e.zigzag {
+		opts = append(opts, "base62.ZigZag()")
+	}
+	if e.littleEndian {
+		opts = append(opts, "base62.ByteOrder(binary.LittleEndian)")
+	}
+	if e.groupSize > 0 {
+		opts = append(opts, fmt.Sprintf("base62.Group(%d, %q)", e.groupSize, e.groupSep))
+	}
+	if e.ignore != "" {
+		opts = append(opts, fmt.Sprintf("base62.IgnoreSeparators(%q)", e.ignore))
+	}
+	if e.lenient {
+		opts = append(opts, "base62.Lenient()")
+	}
+	if e.sign != 0 {
+		opts = append(opts, fmt.Sprintf("base62.Sign(%q)", e.sign))
+	}
+	if e.emptyZero {
+		opts = append(opts, "base62.EmptyZero()")
+	}
+	if e.padChar != 0 {
+		opts = append(opts, fmt.Sprintf("base62.PaddingChar(%q)", e.padChar))
+	}
+	if e.align == AlignLeft {
+		opts = append(opts, "base62.Align(base62.AlignLeft)")
+	}
+	if e.maxDecodeLen > 0 {
+		opts = append(opts, fmt.Sprintf("base62.MaxDecodeLen(%d)", e.maxDecodeLen))
+	}
+	if e.luhn {
+		opts = append(opts, "base62.Luhn()")
+	}
+	if e.strict {
+		opts = append(opts, "base62.Strict()")
+	}
+	if e.caseInsensitive {
+		opts = append(opts, "base62.CaseInsensitive()")
+	}
+	if e.remap != "" {
+		opts = append(opts, fmt.Sprintf("base62.Remap(%q)", e.remap))
+	}
+	if e.blocklist != "" {
+		var words []string
+		for _, w := range strings.Split(e.blocklist, blocklistSep) {
+			words = append(words, strconv.Quote(w))
+		}
+		opts = append(opts, "base62.Blocklist("+strings.Join(words, ", ")+")")
+	}
+	if e.mask != 0 {
+		opts = append(opts, fmt.Sprintf("base62.WithMask(%#x)", e.mask))
+	}
+	if e.letterFirst {
+		opts = append(opts, "base62.LetterFirst()")
+	}
+	if e.sortable {
+		opts = append(opts, "base62.Sortable()")
+	}
+
+	s := fmt.Sprintf("base62.NewEncoding(%q)", e.encode)
+	if len(opts) > 0 {
+		s += ".Option(" + strings.Join(opts, ", ") + ")"
+	}
+	return s
+}
+
+// invalidDigit marks characters outside of the alphabet in the decode table
+const invalidDigit = 0xff
+
 const encodeStd = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
 
-// NewEncoding returns a new Encoding defined by the given alphabet
-func NewEncoding(encoder string) *Encoding {
-	return &Encoding{
+// encodeLower orders lowercase letters before uppercase, as used by
+// several other base62 libraries
+const encodeLower = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// encodeDigitsLast orders digits after letters, as in the base64 alphabet
+const encodeDigitsLast = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+// NewEncoding returns a new Encoding defined by the given alphabet, which
+// must be 62 unique printable ASCII characters, configured with opts. An
+// alphabet including '-' must be given another Sign among opts, so negative
+// values can be encoded. It panics if the alphabet is invalid, use
+// NewEncodingStrict to handle invalid alphabets as errors
+func NewEncoding(encoder string, opts ...option) *Encoding {
+	e, err := NewEncodingStrict(encoder, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return e
+}
+
+// NewEncodingStrict returns a new Encoding defined by the given alphabet,
+// configured with opts, returning an ErrInvalidConfig rather than panicking
+// if the alphabet is invalid, for alphabets built from configuration
+func NewEncodingStrict(encoder string, opts ...option) (*Encoding, error) {
+	if err := validateAlphabet(encoder); err != nil {
+		return nil, err
+	}
+
+	e := newEncoding(encoder).Option(opts...)
+	if err := e.checkSign(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// newEncoding returns a new Encoding defined by an alphabet which has
+// already been validated
+func newEncoding(encoder string) *Encoding {
+	raw, _ := core.NewCodec(encoder)
+	e := &Encoding{
 		encode: encoder,
+		raw:    *raw,
+	}
+
+	// Build the reverse lookup table, in reverse so that the first of any
+	// duplicate characters takes precedence
+	for i := range e.decode {
+		e.decode[i] = invalidDigit
+	}
+	for i := len(encoder) - 1; i >= 0; i-- {
+		e.decode[encoder[i]] = byte(i)
 	}
+
+	return e
 }
 
 // NewStdEncoding returns an Encoding preconfigured with the standard base62 alphabet
 func NewStdEncoding() *Encoding {
-	return NewEncoding(encodeStd)
+	return newEncoding(encodeStd)
 }
 
-// StdEncoding is the standard base62 encoding
-var StdEncoding = NewStdEncoding()
+// StdEncoding is the standard base62 encoding, which is immutable. Use
+// WithOptions or NewStdEncoding to configure a separate encoding
+var StdEncoding = NewStdEncoding().freeze()
 
 // Configurable options for an Encoding
 
 type option func(*Encoding)
 
 // Padding sets the minimum string length returned when encoding
-// strings shorter than this will be left padded with zeros
+// strings shorter than this will be left padded with zeros, or the
+// PaddingChar if set
 func Padding(n int) option {
 	return func(e *Encoding) {
 		e.padding = n
 	}
 }
 
+// PaddingChar sets the character used to left pad encoded integers to the
+// minimum length set by Padding, in place of the zero digit, such as a
+// space or underscore for fixed-width display formats. The character must
+// be outside of the alphabet, and leading padding characters are removed
+// before decoding. This does not apply to fixed-width binary encodings
+func PaddingChar(r rune) option {
+	return func(e *Encoding) {
+		if r == rune(e.encode[0]) {
+			r = 0
+		}
+		e.padChar = r
+	}
+}
+
+// ZigZag sets signed int64 values to be zig-zag encoded, mapping 0, -1, 1,
+// -2, 2... to 0, 1, 2, 3, 4... so that small negative numbers remain short
+// and no sign character is needed. This applies only to int64 values
+func ZigZag() option {
+	return func(e *Encoding) {
+		e.zigzag = true
+	}
+}
+
+// ByteOrder sets whether byte values are interpreted as big-endian or
+// little-endian integers when bridging between bytes and their numeric
+// encoding, such as by EncodeArray or EncodeBinary. The default is big-endian
+func ByteOrder(order binary.ByteOrder) option {
+	return func(e *Encoding) {
+		e.littleEndian = order.Uint16([]byte{1, 0}) == 1
+	}
+}
+
+// EmptyZero sets zero to be encoded as an empty string rather than the zero
+// digit of the alphabet, and empty strings to decode to zero, preserving the
+// behaviour of earlier versions for existing data. Without it, decoding an
+// empty string returns an ErrEmptyInput, which is a breaking change from
+// those versions
+func EmptyZero() option {
+	return func(e *Encoding) {
+		e.emptyZero = true
+	}
+}
+
 /**
  * Encoder
  */
@@ -64,59 +426,131 @@ func EncodeInt64(n int64) string {
 	return StdEncoding.EncodeInt64(n)
 }
 
-// EncodeBigInt returns the base62 encoding of an arbitrary precision integer using the StdEncoding
-func EncodeBigInt(n *big.Int) string {
-	return StdEncoding.EncodeBigInt(n)
+// EncodeInt64 returns the base62 encoding of n. Negative values follow the
+// sign marker, see Sign, unless zig-zag or sortable encoded
+func (e *Encoding) EncodeInt64(n int64) string {
+	n = e.maskInt64(e.permutation.permute(n))
+	if e.sortable {
+		return e.encodeSortable(sortableOffset(uint64(n)))
+	}
+	if n < 0 && !e.zigzag {
+		// Zeros pad between the marker and digits, other characters before
+		if e.padChar != 0 {
+			return e.format(e.padValue(e.luhnAppend(string(e.marker())+e.encodeUint64(-uint64(n))), e.padding))
+		}
+		return e.format(string(e.marker()) + e.pad(e.luhnAppend(e.encodeUint64(-uint64(n))), e.padding-1))
+	}
+
+	s := e.encodeInt64(n)
+	if n == 0 {
+		s = e.zero()
+	}
+	s = e.padValue(e.leadLetter(e.luhnAppend(s)), e.padding)
+
+	return e.format(s)
 }
 
-// EncodeInt64 returns the base62 encoding of n
-func (e *Encoding) EncodeInt64(n int64) string {
-	var (
-		b   = make([]byte, 0)
-		rem int64
-	)
+// EncodeUint64 returns the base62 encoding of n using the StdEncoding
+func EncodeUint64(n uint64) string {
+	return StdEncoding.EncodeUint64(n)
+}
 
-	// Progressively divide by base, store remainder each time
-	// Prepend as an additional character is the higher power
-	for n > 0 {
-		rem = n % base
-		n = n / base
-		b = append([]byte{e.encode[rem]}, b...)
+// EncodeUint64 returns the base62 encoding of an unsigned integer, such as
+// a snowflake or hash above the range of an int64
+func (e *Encoding) EncodeUint64(n uint64) string {
+	n ^= e.mask
+	if e.sortable {
+		return e.encodeSortable(n)
 	}
 
-	s := string(b)
-	if e.padding > 0 {
-		s = e.pad(s, e.padding)
+	s := e.encodeUint64(n)
+	if n == 0 {
+		s = e.zero()
 	}
+	s = e.padValue(e.leadLetter(e.luhnAppend(s)), e.padding)
 
-	return s
+	return e.format(s)
 }
 
-// EncodeBigInt returns the base62 encoding of an arbitrary precision integer
-func (e *Encoding) EncodeBigInt(n *big.Int) string {
+// AppendInt64 appends the base62 encoding of n using the StdEncoding to
+// dst, see Encoding.AppendInt64
+func AppendInt64(dst []byte, n int64) []byte {
+	return StdEncoding.AppendInt64(dst, n)
+}
+
+// AppendInt64 appends the base62 encoding of n, as produced by EncodeInt64,
+// to dst and returns the extended buffer, following strconv.AppendInt
+func (e *Encoding) AppendInt64(dst []byte, n int64) []byte {
+	if e.groupSize > 0 || e.padChar != 0 || e.luhn || e.permutation != nil || e.mask != 0 || e.letterFirst || e.sortable {
+		return append(dst, e.EncodeInt64(n)...)
+	}
+
 	var (
-		b    = make([]byte, 0)
-		rem  = new(big.Int)
-		bse  = new(big.Int)
-		zero = new(big.Int)
+		u   uint64
+		pad = e.padding
 	)
-	bse.SetInt64(base)
-	zero.SetInt64(0)
+	switch {
+	case e.zigzag:
+		u = zigzag(n)
+	case n > 0:
+		u = uint64(n)
+	case n < 0:
+		dst = append(dst, e.marker())
+		u, pad = -uint64(n), pad-1
+	}
 
-	// Progressively divide by base, until we hit zero
-	// store remainder each time
-	// Prepend as an additional character is the higher power
-	for n.Cmp(zero) == 1 {
-		n, rem = n.DivMod(n, bse, rem)
-		b = append([]byte{e.encode[rem.Int64()]}, b...)
+	var (
+		buf    [MaxLenInt64]byte
+		digits = buf[:0]
+	)
+	if u > 0 || !e.emptyZero {
+		digits = e.raw.AppendUint64(digits, u)
 	}
 
-	s := string(b)
-	if e.padding > 0 {
-		s = e.pad(s, e.padding)
+	for l := len(digits); l < pad; l++ {
+		dst = append(dst, e.encode[0])
 	}
+	return append(dst, digits...)
+}
 
-	return s
+// encodeInt64 returns the unpadded base62 encoding of n, which is empty
+// for zero
+func (e *Encoding) encodeInt64(n int64) string {
+	if e.zigzag {
+		return e.encodeUint64(zigzag(n))
+	}
+	if n < 0 {
+		return ""
+	}
+	return e.encodeUint64(uint64(n))
+}
+
+// encodeValue returns the unpadded digits of n, with any sign marker,
+// after any Permutation and mask, as the core of EncodeInt64 without the
+// formatting of whole strings
+func (e *Encoding) encodeValue(n int64) string {
+	n = e.maskInt64(e.permutation.permute(n))
+	switch {
+	case e.sortable:
+		return e.encodeUint64(sortableOffset(uint64(n)))
+	case e.zigzag:
+		return e.encodeUint64(zigzag(n))
+	case n >= 0:
+		return e.encodeUint64(uint64(n))
+	}
+
+	return string(e.marker()) + e.encodeUint64(-uint64(n))
+}
+
+// encodeUint64 returns the unpadded base62 encoding of n, which is empty
+// for zero
+func (e *Encoding) encodeUint64(n uint64) string {
+	if n == 0 {
+		return ""
+	}
+
+	var buf [MaxLenInt64]byte
+	return string(e.raw.AppendUint64(buf[:0], n))
 }
 
 /**
@@ -128,19 +562,35 @@ func DecodeToInt64(s string) (int64, error) {
 	return StdEncoding.DecodeToInt64(s)
 }
 
+// DecodeToUint64 decodes a base62 encoded string to an unsigned integer
+// using the StdEncoding
+func DecodeToUint64(s string) (uint64, error) {
+	return StdEncoding.DecodeToUint64(s)
+}
+
 // MustDecodeToInt64 decodes a base62 encoded string using the StdEncoding
 // panics in the case of an error
 func MustDecodeToInt64(s string) int64 {
 	return StdEncoding.MustDecodeToInt64(s)
 }
 
-// DecodeToBigInt returns an arbitrary precision integer from the base62
-// encoded string using the StdEncoding
-func DecodeToBigInt(s string) (*big.Int, error) {
-	return StdEncoding.DecodeToBigInt(s)
+// TryDecodeToInt64 decodes a base62 encoded string using the StdEncoding
+// returns false if the string is invalid
+func TryDecodeToInt64(s string) (int64, bool) {
+	return StdEncoding.TryDecodeToInt64(s)
 }
 
-type ErrInvalidCharacter struct{ error }
+// DecodeToInt64Or decodes a base62 encoded string using the StdEncoding
+// returns def in the case of an error
+func DecodeToInt64Or(s string, def int64) int64 {
+	return StdEncoding.DecodeToInt64Or(s, def)
+}
+
+// DecodePrefixToInt64 decodes the base62 encoded prefix of a string using
+// the StdEncoding, see Encoding.DecodePrefixToInt64
+func DecodePrefixToInt64(s string) (int64, int, error) {
+	return StdEncoding.DecodePrefixToInt64(s)
+}
 
 // MustDecodeToInt64 decodes a base62 encoded string,
 // it panics in the case of an error
@@ -152,76 +602,306 @@ func (e *Encoding) MustDecodeToInt64(s string) int64 {
 	return v
 }
 
-// DecodeToInt64 decodes a base62 encoded string
+// DecodeToInt64Or decodes a base62 encoded string,
+// it returns def in the case of an error
+func (e *Encoding) DecodeToInt64Or(s string, def int64) int64 {
+	v, err := e.DecodeToInt64(s)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// TryDecodeToInt64 decodes a base62 encoded string, returning false
+// rather than an error if the string is invalid
+func (e *Encoding) TryDecodeToInt64(s string) (int64, bool) {
+	if e.strict || e.luhn || e.permutation != nil || e.mask != 0 || e.sortable {
+		n, err := e.DecodeToInt64(s)
+		return n, err == nil
+	}
+	if e.checkValue(s) != nil {
+		return 0, false
+	}
+
+	s = e.unformat(s)
+	if e.zigzag {
+		n, pos, overflow := e.parseUint64(s)
+		if pos != -1 || overflow {
+			return 0, false
+		}
+		return unzigzag(n), true
+	}
+	if e.signed() && len(s) > 0 && s[0] == e.marker() {
+		n, err := e.decodeNegative(s)
+		return n, err == nil
+	}
+
+	n, pos, overflow := e.parseInt64(s)
+	return n, pos == -1 && !overflow
+}
+
+// DecodeToInt64 decodes a base62 encoded string. An empty string returns an
+// ErrEmptyInput, unless EmptyZero is set
 func (e *Encoding) DecodeToInt64(s string) (int64, error) {
-	var (
-		n     int64
-		c     int64
-		idx   int
-		power int
-	)
+	if err := e.checkValue(s); err != nil {
+		return 0, err
+	}
 
-	for i, v := range s {
-		idx = strings.IndexRune(e.encode, v)
-		if idx == -1 {
-			return 0, ErrInvalidCharacter{fmt.Errorf("Invalid character %c at %d", v, i)}
+	n, err := e.decodeToInt64(s)
+	n = e.permutation.unpermute(e.maskInt64(n))
+	if err == nil && e.strict {
+		err = e.canonical(s, e.EncodeInt64(n))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// decodeToInt64 decodes a formatted string without checking it is canonical
+func (e *Encoding) decodeToInt64(s string) (int64, error) {
+	s, err := e.luhnStrip(e.unformat(s))
+	if err != nil {
+		return 0, err
+	}
+	return e.decodeValue(s)
+}
+
+// decodeValue decodes the unformatted digits of an int64, with any sign
+// marker, without reversing any mask or Permutation
+func (e *Encoding) decodeValue(s string) (int64, error) {
+	if e.sortable {
+		n, err := e.decodeUint64(s)
+		if err != nil {
+			return 0, err
 		}
-		// Work downwards through powers of our base
-		power = len(s) - (i + 1)
+		return int64(sortableOffset(n)), nil
+	}
+	if e.zigzag {
+		n, err := e.decodeUint64(s)
+		if err != nil {
+			return 0, err
+		}
+		return unzigzag(n), nil
+	}
+	if e.signed() && len(s) > 0 && s[0] == e.marker() {
+		return e.decodeNegative(s)
+	}
 
-		// Calculate value at this position and add
-		c = int64(idx) * int64(math.Pow(float64(base), float64(power)))
-		n = n + c
+	n, pos, overflow := e.parseInt64(s)
+	if pos != -1 {
+		return 0, e.invalidCharacter(s, pos)
+	}
+	if overflow {
+		return 0, ErrOverflow{fmt.Errorf("Value of %s overflows int64", s)}
 	}
 
-	return int64(n), nil
+	return n, nil
 }
 
-// DecodeToBigInt returns an arbitrary precision integer from the base62 encoded string
-func (e *Encoding) DecodeToBigInt(s string) (*big.Int, error) {
-	var (
-		n = new(big.Int)
+// DecodeToUint64 decodes a base62 encoded string to an unsigned integer,
+// returning an ErrOverflow if the value exceeds 64 bits
+func (e *Encoding) DecodeToUint64(s string) (uint64, error) {
+	if err := e.checkValue(s); err != nil {
+		return 0, err
+	}
 
-		c     = new(big.Int)
-		idx   = new(big.Int)
-		power = new(big.Int)
-		exp   = new(big.Int)
-		bse   = new(big.Int)
-	)
-	bse.SetInt64(base)
-
-	// Run through each character to decode
-	for i, v := range s {
-		pos := strings.IndexRune(e.encode, v)
-		if pos == -1 {
-			return nil, ErrInvalidCharacter{fmt.Errorf("Invalid character %c at %d",
-				v, i)}
+	v, err := e.luhnStrip(e.unformat(s))
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := e.decodeUint64(v)
+	n ^= e.mask
+	if err == nil && e.strict {
+		err = e.canonical(s, e.EncodeUint64(n))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// DecodePrefixToInt64 decodes the longest valid base62 encoded prefix of a
+// string, returning the value and the number of bytes consumed, so an ID
+// can be pulled from the front of a larger string such as 3kTMd2-title-slug.
+// An error is returned if the string does not start with a valid character
+func (e *Encoding) DecodePrefixToInt64(s string) (int64, int, error) {
+	end := len(s)
+	for i := 0; i < len(s); i++ {
+		if e.index(s[i]) == -1 {
+			end = i
+			break
+		}
+	}
+
+	if end == 0 {
+		if len(s) == 0 {
+			return 0, 0, ErrEmptyInput{fmt.Errorf("Empty string has no prefix to decode")}
 		}
-		// Get index/position of the rune as a big int
-		idx.SetInt64(int64(pos))
+		return 0, 0, e.invalidCharacter(s, 0)
+	}
+
+	n, err := e.DecodeToInt64(s[:end])
+	if err != nil {
+		return 0, 0, err
+	}
 
-		// Work downwards through exponents
-		exp.SetInt64(int64(len(s) - (i + 1)))
+	return n, end, nil
+}
 
-		// Calculate power for this exponent
-		power.Exp(bse, exp, nil)
+// parseInt64 decodes a base62 encoded string without allocating an error,
+// returning the offset of the first invalid character, or -1 if valid, and
+// whether the value exceeds the range of an int64
+func (e *Encoding) parseInt64(s string) (int64, int, bool) {
+	n, pos, overflow := e.parseUint64(s)
+	if pos != -1 {
+		return 0, pos, false
+	}
+	if overflow || n > math.MaxInt64 {
+		return 0, -1, true
+	}
 
-		// Multiplied by our index, gives us the value for this character
-		c = c.Mul(idx, power)
+	return int64(n), -1, false
+}
 
-		// Finally add to running total
-		n.Add(n, c)
+// decodeUint64 decodes a base62 encoded string to an unsigned integer,
+// returning an ErrOverflow if the value exceeds 64 bits
+func (e *Encoding) decodeUint64(s string) (uint64, error) {
+	n, pos, overflow := e.parseUint64(s)
+	if pos != -1 {
+		return 0, e.invalidCharacter(s, pos)
+	}
+	if overflow {
+		return 0, ErrOverflow{fmt.Errorf("Value of %s overflows 64 bits", s)}
 	}
 
 	return n, nil
 }
 
-// pad a string to a minimum length with zero characters
+// parseUint64 decodes a base62 encoded string to an unsigned integer
+// without allocating an error, returning the offset of the first invalid
+// character, or -1 if valid, and whether the value exceeds 64 bits
+func (e *Encoding) parseUint64(s string) (uint64, int, bool) {
+	var n uint64
+
+	for i := 0; i < len(s); i++ {
+		idx := e.index(s[i])
+		if idx == -1 {
+			return 0, i, false
+		}
+
+		// Shift up by our base and add the value at this position,
+		// evaluating the digits by Horner's method in integers
+		if n > (math.MaxUint64-uint64(idx))/base {
+			return 0, -1, true
+		}
+		n = n*base + uint64(idx)
+	}
+
+	return n, -1, false
+}
+
+// invalidCharacter returns the error for the invalid character at
+// offset i of s, suggesting corrections of plausible typos
+func (e *Encoding) invalidCharacter(s string, i int) error {
+	v, _ := utf8.DecodeRuneInString(s[i:])
+	return e.invalidRune(v, i, e.suggest(s))
+}
+
+// invalidRune returns the error for the invalid character v at offset i,
+// with the suggested correction of the input if there is one
+func (e *Encoding) invalidRune(v rune, i int, suggestion string) error {
+	err := ErrInvalidCharacter{
+		Char:       v,
+		Offset:     i,
+		Candidates: e.candidates(v),
+		Suggestion: suggestion,
+	}
+
+	msg := fmt.Sprintf("Invalid character %c at %d", v, i)
+	if err.Suggestion != "" {
+		msg += fmt.Sprintf(", did you mean %s?", err.Suggestion)
+	}
+	err.error = errors.New(msg)
+
+	return err
+}
+
+// index returns the value of a character in the alphabet, or -1 if the
+// character is not in the alphabet
+func (e *Encoding) index(c byte) int {
+	if d := e.decode[c]; d != invalidDigit {
+		return int(d)
+	}
+	return -1
+}
+
+// digits returns the alphabet index of each character of an encoded string,
+// most significant first
+func (e *Encoding) digits(s string) ([]byte, error) {
+	d := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		idx := e.index(s[i])
+		if idx == -1 {
+			return nil, e.invalidCharacter(s, i)
+		}
+		d = append(d, byte(idx))
+	}
+
+	return d, nil
+}
+
+// inStep reports whether encodings are the plain digits of their values,
+// so that arithmetic may be done directly on the digits. Otherwise values
+// must be decoded and re-encoded, as they are mapped through a Permutation
+// or mask, or formatted with check characters, groups or a leading letter
+func (e *Encoding) inStep() bool {
+	return e.permutation == nil && e.mask == 0 && !e.luhn && !e.sortable && !e.letterFirst && e.groupSize == 0
+}
+
+// zero returns the unpadded encoding of zero
+func (e *Encoding) zero() string {
+	if e.emptyZero {
+		return ""
+	}
+	return e.encode[:1]
+}
+
+// pad a string to a minimum length with the zero character of the alphabet
 func (e *Encoding) pad(s string, minlen int) string {
 	if len(s) >= minlen {
 		return s
 	}
 
-	format := fmt.Sprint(`%0`, strconv.Itoa(minlen), "s")
-	return fmt.Sprintf(format, s)
+	return strings.Repeat(e.encode[:1], minlen-len(s)) + s
+}
+
+// padValue pads an encoded integer to a minimum length with the padding
+// character of the encoding, on the side given by its alignment
+func (e *Encoding) padValue(s string, minlen int) string {
+	if e.padChar == 0 {
+		return e.pad(s, minlen)
+	}
+	if len(s) >= minlen {
+		return s
+	}
+
+	p := strings.Repeat(string(e.padChar), minlen-len(s))
+	if e.align == AlignLeft {
+		return s + p
+	}
+	return p + s
+}
+
+// unpad removes padding characters from either end of an encoded integer,
+// the zero digit is left in place as it decodes the same either way
+func (e *Encoding) unpad(s string) string {
+	if e.padChar == 0 {
+		return s
+	}
+
+	return strings.Trim(s, string(e.padChar))
 }
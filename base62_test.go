@@ -2,10 +2,13 @@ package base62
 
 import (
 	"fmt"
-	"math/big"
+	"math"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/mattheath/base62/core"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -65,6 +68,69 @@ func BenchmarkEncodeInt64Long(b *testing.B) {
 	result = id
 }
 
+func TestEncodeUint64(t *testing.T) {
+	uint64Testcases := []struct {
+		num     uint64
+		encoded string
+	}{
+		{0, "0"},
+		{4815162342, "5Frvgk"},
+		{math.MaxInt64, "AzL8n0Y58m7"},
+		{math.MaxInt64 + 1, "AzL8n0Y58m8"},
+		{math.MaxUint64, "LygHa16AHYF"},
+	}
+
+	for _, tc := range uint64Testcases {
+		v := EncodeUint64(tc.num)
+		t.Logf("Encoded %v as %s", tc.num, v)
+		assert.Equal(t, tc.encoded, v)
+
+		n, err := DecodeToUint64(v)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.num, n)
+	}
+
+	assert.Equal(t, "0000LygHa16AHYF", NewStdEncoding().Option(Padding(15)).EncodeUint64(math.MaxUint64))
+
+	_, err := DecodeToUint64("LygHa16AHYG")
+	assert.IsType(t, ErrOverflow{}, err)
+	_, err = DecodeToUint64("5Frv-gk")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestAppendInt64(t *testing.T) {
+	for _, tc := range testcases {
+		v := AppendInt64([]byte("id:"), tc.num)
+		assert.Equal(t, "id:"+tc.encoded, string(v))
+	}
+
+	for _, e := range []*Encoding{
+		NewStdEncoding().Option(Padding(15)),
+		NewStdEncoding().Option(ZigZag()),
+		NewStdEncoding().Option(Group(4, "-")),
+	} {
+		for _, n := range []int64{0, 1, -1, 4815162342, math.MaxInt64, math.MinInt64} {
+			assert.Equal(t, e.EncodeInt64(n), string(e.AppendInt64(nil, n)), "%v %d", e, n)
+		}
+	}
+}
+
+func TestAppendInt64Allocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendInt64(buf[:0], 4815162342)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
+
+func BenchmarkAppendInt64Long(b *testing.B) {
+	buf := make([]byte, 0, MaxLenInt64)
+	for n := 0; n < b.N; n++ {
+		buf = AppendInt64(buf[:0], 9223372036854775807)
+	}
+	result = string(buf)
+}
+
 var paddedTestcases = []struct {
 	num     int64
 	encoded string
@@ -106,83 +172,6 @@ func TestEncodeInt64WithVaryingPadding(t *testing.T) {
 	}
 }
 
-var bigTestcases = []struct {
-	num     string
-	encoded string
-}{
-	{"1", "1"},
-	{"9", "9"},
-	{"10", "A"},
-	{"35", "Z"},
-	{"36", "a"},
-	{"61", "z"},
-	{"62", "10"},
-	{"99", "1b"},
-	{"3844", "100"},
-	{"3860", "10G"},
-	{"4815162342", "5Frvgk"},
-
-	{"9223372036854775807", "AzL8n0Y58m7"},       // max signed int64
-	{"9223372036854775809", "AzL8n0Y58m9"},       // beyond int64
-	{"9223372036854775861", "AzL8n0Y58mz"},       //
-	{"18446744073709551615", "LygHa16AHYF"},      // max uint64
-	{"571849066284996100034", "AzL8n0Y58m70"},    // max int64 * 62
-	{"35454642109669758202168", "AzL8n0Y58m70y"}, // (max int64 * 62^2) + 60
-
-	{"24467927614188555520896788267013", "8HFaR8qWtRlGDHnO57"}, // a few boundary flake id tests
-	{"24467927614170108776823078715395", "8HFaR8qAulTgCBd6Wp"},
-	{"24467927614170108776823078715394", "8HFaR8qAulTgCBd6Wo"},
-	{"24467927614170108776823078715393", "8HFaR8qAulTgCBd6Wn"},
-	{"24467927614170108776823078715392", "8HFaR8qAulTgCBd6Wm"},
-
-	{"170141183460469231731687303715884105727", "3tX16dB2jpss4tZORYcqo3"}, // max signed 128bit int
-	{"170141183460469231731687303715884105757", "3tX16dB2jpss4tZORYcqoX"}, // max signed 128bit int + 30
-	{"340282366920938463463374607431768211455", "7n42DGM5Tflk9n8mt7Fhc7"}, // max unsigned 128bit int
-
-	{"2707803647802660400290261537185326956543", "zzzzzzzzzzzzzzzzzzzzzz"}, // max 22 character when encoded
-}
-
-func TestEncodeBigInt(t *testing.T) {
-	for _, tc := range bigTestcases {
-		var (
-			n  = new(big.Int)
-			ok bool
-		)
-
-		n, ok = n.SetString(tc.num, 10)
-		require.True(t, ok)
-
-		v := EncodeBigInt(n)
-		t.Logf("Encoded %v as %s", tc.num, v)
-		assert.Equal(t, tc.encoded, v)
-	}
-}
-
-func BenchmarkEncodeBigIntVeryLong(b *testing.B) {
-	var (
-		v = new(big.Int)
-		s string
-	)
-	v.SetString("340282366920938463463374607431768211455", 10)
-
-	b.ResetTimer()
-	for n := 0; n < b.N; n++ {
-		s = EncodeBigInt(v)
-	}
-	result = s
-}
-
-func TestDecodeToBigInt(t *testing.T) {
-	for _, tc := range bigTestcases {
-		v, err := DecodeToBigInt(tc.encoded)
-		if err != nil {
-			t.Fatal(err)
-		}
-		t.Logf("Decoded %v to %s", tc.encoded, v.String())
-		assert.Equal(t, tc.num, v.String())
-	}
-}
-
 // Ensure than padded base62 strings are correctly decoded
 
 func TestPaddedDecodeToInt64(t *testing.T) {
@@ -204,26 +193,6 @@ func TestPaddedDecodeToInt64(t *testing.T) {
 	}
 }
 
-func TestPaddedDecodeToBigInt(t *testing.T) {
-	testcases := []struct {
-		encoded string
-		result  string
-	}{
-		{"0000000000000000000000000000000000005Frvgk", "4815162342"},
-		{"000000000000000000003tX16dB2jpss4tZORYcqoX", "170141183460469231731687303715884105757"},
-		{"000000000000000000007n42DGM5Tflk9n8mt7Fhc7", "340282366920938463463374607431768211455"},
-	}
-
-	for _, tc := range testcases {
-		v, err := DecodeToBigInt(tc.encoded)
-		if err != nil {
-			t.Fatal(err)
-		}
-		t.Logf("Decoded %s to %v", tc.encoded, v)
-		assert.Equal(t, tc.result, v.String())
-	}
-}
-
 // TestLexicalPaddedSort tests that numbers encoded as base62 strings
 // are correctly lexically sorted with the original order preserved
 // if these are left padded to the same length.
@@ -276,3 +245,345 @@ func padStringArray(s []string, minlen int) []string {
 	}
 	return s
 }
+
+func TestClone(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(4))
+	c := e.Clone().Option(Padding(8), ZigZag())
+
+	assert.Equal(t, "005Frvgk", c.EncodeInt64(4815162342/2))
+	assert.Equal(t, "000A", e.EncodeInt64(10))
+	assert.Equal(t, "00000001", c.EncodeInt64(-1))
+}
+
+func TestWithOptions(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(4))
+	c := e.WithOptions(Padding(8), ZigZag())
+
+	assert.Equal(t, "005Frvgk", c.EncodeInt64(4815162342/2))
+	assert.Equal(t, "000A", e.EncodeInt64(10))
+}
+
+func TestStdEncodingImmutable(t *testing.T) {
+	e := StdEncoding.Option(Padding(8))
+
+	assert.Equal(t, "0000000A", e.EncodeInt64(10))
+	assert.Equal(t, "A", StdEncoding.EncodeInt64(10))
+	assert.Equal(t, 0, StdEncoding.Padding())
+
+	// Copies of the StdEncoding may be configured in place
+	c := StdEncoding.Clone()
+	c.Option(Padding(4))
+	assert.Equal(t, 4, c.Padding())
+}
+
+func TestEqual(t *testing.T) {
+	assert.True(t, NewStdEncoding().Equal(StdEncoding))
+	assert.True(t, StdEncoding.Clone().Equal(StdEncoding))
+	assert.True(t, NewStdEncoding().Option(Padding(4)).Equal(NewStdEncoding().Option(Padding(4))))
+
+	assert.False(t, NewStdEncoding().Option(Padding(4)).Equal(StdEncoding))
+	assert.False(t, NewStdEncoding().Option(ZigZag()).Equal(StdEncoding))
+	assert.False(t, NewEncoding("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789").Equal(StdEncoding))
+	assert.False(t, StdEncoding.Equal(nil))
+}
+
+func TestIntrospection(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(8))
+
+	assert.Equal(t, encodeStd, e.Alphabet())
+	assert.Equal(t, 8, e.Padding())
+	assert.Equal(t, 0, StdEncoding.Padding())
+}
+
+func TestEncodingString(t *testing.T) {
+	assert.Equal(t,
+		`base62.Encoding{alphabet: "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz", padding: 0}`,
+		StdEncoding.String())
+	assert.Equal(t,
+		`base62.Encoding{alphabet: "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz", padding: 8, zigzag}`,
+		fmt.Sprint(NewStdEncoding().Option(Padding(8), ZigZag())))
+	assert.Equal(t,
+		`base62.Encoding{alphabet: "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz", padding: 0, group: 4 "-"}`,
+		fmt.Sprint(NewStdEncoding().Option(Group(4, "-"))))
+	assert.Equal(t,
+		`base62.Encoding{alphabet: "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz", padding: 8, padding char: ' '}`,
+		fmt.Sprint(NewStdEncoding().Option(Padding(8), PaddingChar(' '))))
+}
+
+func TestEncodingGoString(t *testing.T) {
+	assert.Equal(t,
+		`base62.NewEncoding("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz")`,
+		fmt.Sprintf("%#v", StdEncoding))
+	assert.Equal(t,
+		`base62.NewEncoding("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz").Option(base62.Padding(8), base62.ZigZag())`,
+		fmt.Sprintf("%#v", NewStdEncoding().Option(Padding(8), ZigZag())))
+	assert.Equal(t,
+		`base62.NewEncoding("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz").Option(base62.Group(4, "-"))`,
+		fmt.Sprintf("%#v", NewStdEncoding().Option(Group(4, "-"))))
+	assert.Equal(t,
+		`base62.NewEncoding("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz").Option(base62.Padding(8), base62.PaddingChar(' '))`,
+		fmt.Sprintf("%#v", NewStdEncoding().Option(Padding(8), PaddingChar(' '))))
+}
+
+func TestDecodeToInt64Or(t *testing.T) {
+	for _, tc := range testcases {
+		assert.Equal(t, tc.num, DecodeToInt64Or(tc.encoded, -1))
+	}
+
+	assert.Equal(t, int64(-1), DecodeToInt64Or("5Frv-gk", -1))
+	assert.Equal(t, int64(42), NewStdEncoding().Option(ZigZag()).DecodeToInt64Or("zzzzzzzzzzzz", 42))
+}
+
+func TestTryDecodeToInt64(t *testing.T) {
+	for _, tc := range testcases {
+		v, ok := TryDecodeToInt64(tc.encoded)
+		assert.True(t, ok)
+		assert.Equal(t, tc.num, v)
+	}
+
+	_, ok := TryDecodeToInt64("5Frv-gk")
+	assert.False(t, ok)
+
+	e := NewStdEncoding().Option(ZigZag())
+	v, ok := e.TryDecodeToInt64("1")
+	assert.True(t, ok)
+	assert.Equal(t, int64(-1), v)
+
+	_, ok = e.TryDecodeToInt64("zzzzzzzzzzzz")
+	assert.False(t, ok)
+}
+
+func TestTryDecodeToInt64InvalidAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		TryDecodeToInt64("5Frv-gk")
+	})
+	assert.Equal(t, float64(0), allocs)
+}
+
+func TestDecodeToInt64InvalidMultibyte(t *testing.T) {
+	_, err := DecodeToInt64("5Fr€gk")
+	assert.EqualError(t, err, "Invalid character € at 3")
+}
+
+func TestDecodePrefixToInt64(t *testing.T) {
+	testcases := []struct {
+		s        string
+		result   int64
+		consumed int
+	}{
+		{"5Frvgk", 4815162342, 6},
+		{"5Frvgk-title-slug", 4815162342, 6},
+		{"A/path", 10, 1},
+		{"10 20", 62, 2},
+	}
+
+	for _, tc := range testcases {
+		v, n, err := DecodePrefixToInt64(tc.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Decoded prefix of %s to %v consuming %d", tc.s, v, n)
+		assert.Equal(t, tc.result, v)
+		assert.Equal(t, tc.consumed, n)
+	}
+}
+
+func TestDecodePrefixToInt64Invalid(t *testing.T) {
+	_, n, err := DecodePrefixToInt64("-slug")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+	assert.Equal(t, 0, n)
+
+	_, _, err = DecodePrefixToInt64("")
+	assert.IsType(t, ErrEmptyInput{}, err)
+	assert.ErrorIs(t, err, ErrInvalidLength{})
+
+	_, _, err = NewStdEncoding().Option(ZigZag()).DecodePrefixToInt64("zzzzzzzzzzzz-slug")
+	assert.IsType(t, ErrOverflow{}, err)
+}
+
+func TestPaddedCustomAlphabet(t *testing.T) {
+	e := NewEncoding("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789").Option(Padding(6))
+
+	v := e.EncodeInt64(72)
+	assert.Equal(t, "aaaabk", v)
+
+	n, err := e.DecodeToInt64(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(72), n)
+}
+
+func TestEncodeZero(t *testing.T) {
+	assert.Equal(t, "0", EncodeInt64(0))
+	assert.Equal(t, "0", EncodeUint64(0))
+	assert.Equal(t, "0", string(AppendInt64(nil, 0)))
+	assert.Equal(t, "a", NewEncoding("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789").EncodeInt64(0))
+
+	legacy := NewStdEncoding().Option(EmptyZero())
+	assert.Equal(t, "", legacy.EncodeInt64(0))
+	assert.Equal(t, "", legacy.EncodeUint64(0))
+	assert.Equal(t, "", string(legacy.AppendInt64(nil, 0)))
+	assert.Equal(t, "0000", legacy.Clone().Option(Padding(4)).EncodeInt64(0))
+
+	// Both decode to zero, but only EmptyZero accepts an empty string
+	for _, s := range []string{"", "0", "0000"} {
+		n, err := legacy.DecodeToInt64(s)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), n)
+	}
+	for _, s := range []string{"0", "0000"} {
+		n, err := DecodeToInt64(s)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), n)
+	}
+	_, err := DecodeToInt64("")
+	assert.IsType(t, ErrEmptyInput{}, err)
+}
+
+func TestCoreParity(t *testing.T) {
+	for _, n := range []int64{0, 1, 61, 62, 4815162342, math.MaxInt64, -1, math.MinInt64} {
+		v, ok := core.Std.AppendInt64(nil, n)
+		if n < 0 {
+			// The core codec has no signed encoding
+			assert.False(t, ok, "%d", n)
+			continue
+		}
+		assert.True(t, ok, "%d", n)
+		assert.Equal(t, EncodeInt64(n), string(v), "%d", n)
+
+		d, ok := core.Std.DecodeInt64([]byte(EncodeInt64(n)))
+		assert.True(t, ok, "%d", n)
+		assert.Equal(t, n, d)
+	}
+
+	for _, n := range []uint64{0, 1, 62, math.MaxUint64} {
+		assert.Equal(t, EncodeUint64(n), string(core.Std.AppendUint64(nil, n)), "%d", n)
+	}
+
+	// Both reject empty input
+	_, ok := core.Std.DecodeUint64(nil)
+	assert.False(t, ok)
+	_, err := DecodeToUint64("")
+	assert.Error(t, err)
+}
+
+func TestPaddingChar(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(8), PaddingChar('_'))
+
+	testcases := []struct {
+		num     int64
+		encoded string
+	}{
+		{0, "_______0"},
+		{72, "______1A"},
+		{4815162342, "__5Frvgk"},
+		{math.MaxInt64, "AzL8n0Y58m7"},
+	}
+
+	for _, tc := range testcases {
+		v := e.EncodeInt64(tc.num)
+		t.Logf("Encoded %d as %s", tc.num, v)
+		assert.Equal(t, tc.encoded, v)
+		assert.Equal(t, tc.encoded, string(e.AppendInt64(nil, tc.num)))
+		assert.Equal(t, tc.encoded, e.EncodeUint64(uint64(tc.num)))
+
+		n, err := e.DecodeToInt64(v)
+		require.NoError(t, err)
+		assert.Equal(t, tc.num, n)
+	}
+
+	// The padding character is not a digit elsewhere in the value
+	_, err := e.DecodeToInt64("__5F_rvgk")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+
+	// Other characters pad before the sign marker
+	signed := e.Clone().Option(Sign('-'))
+	assert.Equal(t, "______-5", signed.EncodeInt64(-5))
+	n, err := signed.DecodeToInt64("______-5")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-5), n)
+
+	// Setting the zero digit is the same as the default
+	assert.True(t, NewStdEncoding().Option(PaddingChar('0')).Equal(NewStdEncoding()))
+}
+
+func TestDecodeToInt64Boundary(t *testing.T) {
+	testcases := []struct {
+		encoded string
+		num     int64
+	}{
+		{"AzL8n0Y58m7", math.MaxInt64},
+		{"AzL8n0Y58m6", math.MaxInt64 - 1},
+		{"0000AzL8n0Y58m7", math.MaxInt64},
+		{"fFgnDxSe9", 1<<53 + 1},
+		{"fFgnDxSeA", 1<<53 + 2},
+	}
+
+	for _, tc := range testcases {
+		v, err := DecodeToInt64(tc.encoded)
+		require.NoError(t, err)
+		assert.Equal(t, tc.num, v, tc.encoded)
+
+		v, ok := TryDecodeToInt64(tc.encoded)
+		assert.True(t, ok)
+		assert.Equal(t, tc.num, v, tc.encoded)
+	}
+
+	for _, s := range []string{"AzL8n0Y58m8", "LygHa16AHYF", "zzzzzzzzzzzz"} {
+		_, err := DecodeToInt64(s)
+		assert.IsType(t, ErrOverflow{}, err, s)
+
+		_, ok := TryDecodeToInt64(s)
+		assert.False(t, ok)
+	}
+}
+
+func TestDecodeTable(t *testing.T) {
+	for c := 0; c < 256; c++ {
+		assert.Equal(t, strings.IndexByte(encodeStd, byte(c)), StdEncoding.index(byte(c)))
+	}
+
+	// Multi-byte characters are reported at their first byte
+	_, err := DecodeToInt64("5F€gk")
+	var invalid ErrInvalidCharacter
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, '€', invalid.Char)
+	assert.Equal(t, 2, invalid.Offset)
+}
+
+func BenchmarkDecodeToInt64Long(b *testing.B) {
+	var n int64
+	for i := 0; i < b.N; i++ {
+		n, _ = DecodeToInt64("AzL8n0Y58m7")
+	}
+	result = strconv.FormatInt(n, 10)
+}
+
+func TestNewEncodingInvalid(t *testing.T) {
+	for _, alphabet := range []string{
+		"",
+		"0123456789",
+		"0023456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz",
+		"\t123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz",
+		"\xff123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz",
+	} {
+		assert.Panics(t, func() { NewEncoding(alphabet) }, alphabet)
+	}
+}
+
+func TestNewEncodingStrict(t *testing.T) {
+	e, err := NewEncodingStrict("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
+	require.NoError(t, err)
+	assert.Equal(t, "a", e.EncodeInt64(0))
+
+	for _, alphabet := range []string{
+		"0123456789",
+		"0023456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz",
+	} {
+		e, err := NewEncodingStrict(alphabet)
+		t.Logf("Alphabet %q failed with %v", alphabet, err)
+		assert.IsType(t, ErrInvalidConfig{}, err)
+		assert.Nil(t, e)
+	}
+}
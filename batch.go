@@ -0,0 +1,87 @@
+package base62
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrBatchElement identifies the element of a batch which failed to decode,
+// wrapping the reason it failed
+type ErrBatchElement struct {
+	Index int
+	error
+}
+
+// Error returns the error message
+func (e ErrBatchElement) Error() string {
+	return errorString(e.error, fmt.Sprintf("element %d failed to decode", e.Index))
+}
+
+// Unwrap returns the reason the element failed to decode
+func (e ErrBatchElement) Unwrap() error {
+	return e.error
+}
+
+// DecodeToInt64Batch decodes each of the base62 encoded strings using the
+// StdEncoding, see Encoding.DecodeToInt64Batch
+func DecodeToInt64Batch(tokens []string) ([]int64, error) {
+	return StdEncoding.DecodeToInt64Batch(tokens)
+}
+
+// DecodeToInt64Batch decodes each of the base62 encoded strings, continuing
+// past any failures so all bad elements are reported in one pass. Elements
+// which fail to decode are left as zero and the returned error joins an
+// ErrBatchElement for each of them, in order
+func (e *Encoding) DecodeToInt64Batch(tokens []string) ([]int64, error) {
+	var (
+		values = make([]int64, len(tokens))
+		errs   []error
+	)
+
+	for i, s := range tokens {
+		v, err := e.DecodeToInt64(s)
+		if err != nil {
+			errs = append(errs, ErrBatchElement{i, fmt.Errorf("Element %d: %w", i, err)})
+			continue
+		}
+		values[i] = v
+	}
+
+	return values, errors.Join(errs...)
+}
+
+// EncodeInt64List returns the base62 encodings of vals joined by sep using
+// the StdEncoding, see Encoding.EncodeInt64List
+func EncodeInt64List(vals []int64, sep string) string {
+	return StdEncoding.EncodeInt64List(vals, sep)
+}
+
+// ParseInt64List decodes a list of base62 encoded values separated by sep
+// using the StdEncoding, see Encoding.ParseInt64List
+func ParseInt64List(s, sep string) ([]int64, error) {
+	return StdEncoding.ParseInt64List(s, sep)
+}
+
+// EncodeInt64List returns the base62 encodings of vals joined by sep,
+// such as for a comma separated query parameter of IDs
+func (e *Encoding) EncodeInt64List(vals []int64, sep string) string {
+	encoded := make([]string, len(vals))
+	for i, n := range vals {
+		encoded[i] = e.EncodeInt64(n)
+	}
+
+	return strings.Join(encoded, sep)
+}
+
+// ParseInt64List decodes a list of base62 encoded values separated by sep.
+// As with DecodeToInt64Batch every element is decoded, with failures
+// reported together as an ErrBatchElement for each. An empty string is
+// an empty list
+func (e *Encoding) ParseInt64List(s, sep string) ([]int64, error) {
+	if s == "" {
+		return []int64{}, nil
+	}
+
+	return e.DecodeToInt64Batch(strings.Split(s, sep))
+}
@@ -0,0 +1,76 @@
+package base62
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeToInt64Batch(t *testing.T) {
+	tokens := make([]string, 0, len(testcases))
+	for _, tc := range testcases {
+		tokens = append(tokens, tc.encoded)
+	}
+
+	values, err := DecodeToInt64Batch(tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, tc := range testcases {
+		assert.Equal(t, tc.num, values[i])
+	}
+}
+
+func TestDecodeToInt64BatchErrors(t *testing.T) {
+	values, err := DecodeToInt64Batch([]string{"1", "1-", "10", "_"})
+	assert.Equal(t, []int64{1, 0, 62, 0}, values)
+	assert.EqualError(t, err, "Element 1: Invalid character - at 1\nElement 3: Invalid character _ at 0")
+
+	var elem ErrBatchElement
+	if assert.True(t, errors.As(err, &elem)) {
+		assert.Equal(t, 1, elem.Index)
+	}
+
+	var invalid ErrInvalidCharacter
+	assert.True(t, errors.As(err, &invalid))
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if assert.True(t, ok) {
+		var indexes []int
+		for _, err := range joined.Unwrap() {
+			indexes = append(indexes, err.(ErrBatchElement).Index)
+		}
+		assert.Equal(t, []int{1, 3}, indexes)
+	}
+}
+
+func TestInt64List(t *testing.T) {
+	testcases := []struct {
+		vals    []int64
+		sep     string
+		encoded string
+	}{
+		{[]int64{}, ",", ""},
+		{[]int64{1}, ",", "1"},
+		{[]int64{1, 62, 4815162342}, ",", "1,10,5Frvgk"},
+		{[]int64{10, 35}, ", ", "A, Z"},
+	}
+
+	for _, tc := range testcases {
+		v := EncodeInt64List(tc.vals, tc.sep)
+		assert.Equal(t, tc.encoded, v)
+
+		vals, err := ParseInt64List(v, tc.sep)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.vals, vals)
+	}
+}
+
+func TestParseInt64ListErrors(t *testing.T) {
+	vals, err := ParseInt64List("1,,A,B C", ",")
+	assert.Equal(t, []int64{1, 0, 10, 0}, vals)
+	assert.EqualError(t, err, "Element 1: Empty string has no value to decode\nElement 3: Invalid character   at 1")
+}
@@ -0,0 +1,253 @@
+//go:build !base62_nobig
+
+package base62
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+/**
+ * Arbitrary precision integers, which may be excluded from builds for
+ * constrained targets such as TinyGo with the base62_nobig build tag
+ */
+
+// EncodeBigInt returns the base62 encoding of an arbitrary precision integer using the StdEncoding
+func EncodeBigInt(n *big.Int) string {
+	return StdEncoding.EncodeBigInt(n)
+}
+
+// DecodeToBigInt returns an arbitrary precision integer from the base62
+// encoded string using the StdEncoding
+func DecodeToBigInt(s string) (*big.Int, error) {
+	return StdEncoding.DecodeToBigInt(s)
+}
+
+// MustDecodeToBigInt decodes a base62 encoded string using the StdEncoding
+// panics in the case of an error
+func MustDecodeToBigInt(s string) *big.Int {
+	return StdEncoding.MustDecodeToBigInt(s)
+}
+
+// EncodeBigInt returns the base62 encoding of an arbitrary precision
+// integer. Negative values are encoded as their magnitude following the
+// Sign marker of the encoding, or '-' if none is set
+func (e *Encoding) EncodeBigInt(n *big.Int) string {
+	if n.Sign() < 0 {
+		marker := e.marker()
+		s := e.encodeBigInt(new(big.Int).Neg(n))
+		if e.padChar != 0 {
+			return e.format(e.padValue(e.luhnAppend(string(marker)+s), e.padding))
+		}
+		return e.format(string(marker) + e.pad(e.luhnAppend(s), e.padding-1))
+	}
+
+	zero := n.Sign() == 0
+
+	s := e.encodeBigInt(n)
+	if zero {
+		s = e.zero()
+	}
+	s = e.padValue(e.leadLetter(e.luhnAppend(s)), e.padding)
+
+	return e.format(s)
+}
+
+// AppendBigInt appends the base62 encoding of n using the StdEncoding to
+// dst, see Encoding.AppendBigInt
+func AppendBigInt(dst []byte, n *big.Int) []byte {
+	return StdEncoding.AppendBigInt(dst, n)
+}
+
+// AppendBigInt appends the base62 encoding of n, as produced by
+// EncodeBigInt, to dst and returns the extended buffer. Unlike EncodeBigInt
+// n is left unmodified
+func (e *Encoding) AppendBigInt(dst []byte, n *big.Int) []byte {
+	return append(dst, e.EncodeBigInt(new(big.Int).Set(n))...)
+}
+
+// encodeBigInt returns the unpadded base62 encoding of an arbitrary
+// precision integer
+func (e *Encoding) encodeBigInt(n *big.Int) string {
+	var (
+		b    = make([]byte, 0)
+		rem  = new(big.Int)
+		bse  = new(big.Int)
+		zero = new(big.Int)
+	)
+	bse.SetInt64(base)
+	zero.SetInt64(0)
+
+	// Progressively divide by base, until we hit zero
+	// store remainder each time
+	// Prepend as an additional character is the higher power
+	for n.Cmp(zero) == 1 {
+		n, rem = n.DivMod(n, bse, rem)
+		b = append([]byte{e.encode[rem.Int64()]}, b...)
+	}
+
+	return string(b)
+}
+
+// DecodeToBigInt returns an arbitrary precision integer from the base62
+// encoded string, which is negative if it starts with the sign marker
+func (e *Encoding) DecodeToBigInt(s string) (*big.Int, error) {
+	if err := e.checkValue(s); err != nil {
+		return nil, err
+	}
+
+	n, err := e.decodeToBigInt(s)
+	if err == nil && e.strict {
+		err = e.canonical(s, e.EncodeBigInt(new(big.Int).Set(n)))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// decodeToBigInt decodes a formatted string without checking it is canonical
+func (e *Encoding) decodeToBigInt(s string) (*big.Int, error) {
+	s, err := e.luhnStrip(e.unformat(s))
+	if err != nil {
+		return nil, err
+	}
+
+	if marker := e.marker(); len(s) > 0 && s[0] == marker && e.index(marker) == -1 {
+		if len(s) == 1 {
+			return nil, ErrInvalidLength{fmt.Errorf("Sign %c must be followed by digits", marker)}
+		}
+
+		n, err := e.decodeBigInt(s, 1)
+		if err != nil {
+			return nil, err
+		}
+		return n.Neg(n), nil
+	}
+
+	return e.decodeBigInt(s, 0)
+}
+
+// decodeBigInt decodes the digits of s from the given offset
+func (e *Encoding) decodeBigInt(s string, from int) (*big.Int, error) {
+	var (
+		n = new(big.Int)
+
+		c     = new(big.Int)
+		idx   = new(big.Int)
+		power = new(big.Int)
+		exp   = new(big.Int)
+		bse   = new(big.Int)
+	)
+	bse.SetInt64(base)
+
+	// Run through each character to decode
+	for i := from; i < len(s); i++ {
+		pos := e.index(s[i])
+		if pos == -1 {
+			return nil, e.invalidCharacter(s, i)
+		}
+		// Get index/position of the rune as a big int
+		idx.SetInt64(int64(pos))
+
+		// Work downwards through exponents
+		exp.SetInt64(int64(len(s) - (i + 1)))
+
+		// Calculate power for this exponent
+		power.Exp(bse, exp, nil)
+
+		// Multiplied by our index, gives us the value for this character
+		c = c.Mul(idx, power)
+
+		// Finally add to running total
+		n.Add(n, c)
+	}
+
+	return n, nil
+}
+
+// MustDecodeToBigInt decodes a base62 encoded string,
+// it panics in the case of an error
+func (e *Encoding) MustDecodeToBigInt(s string) *big.Int {
+	v, err := e.DecodeToBigInt(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// EncodedLenBig returns the length of the unpadded base62 encoding of n,
+// computed from its bit length without encoding it, so buffers can be
+// preallocated and column widths validated. Zero is a single zero digit,
+// and negative values include their sign marker
+func EncodedLenBig(n *big.Int) int {
+	switch n.Sign() {
+	case -1:
+		return 1 + EncodedLenBig(new(big.Int).Neg(n))
+	case 0:
+		return 1
+	}
+
+	// The bit length bounds n within [2^(b-1), 2^b), which is within a
+	// single digit of the exact length. Resolve the estimate by comparing
+	// against the power of our base at which the length changes
+	l := int(math.Ceil(float64(n.BitLen()) / math.Log2(base)))
+	if n.Cmp(new(big.Int).Exp(big.NewInt(base), big.NewInt(int64(l-1)), nil)) < 0 {
+		l--
+	}
+
+	return l
+}
+
+// selfTestBig verifies round trips of big integers either side of
+// common boundaries, for SelfTest
+func (e *Encoding) selfTestBig() error {
+	one := big.NewInt(1)
+
+	var values []*big.Int
+	for _, p := range []*big.Int{
+		new(big.Int).Lsh(one, 64),
+		new(big.Int).Lsh(one, 127),
+		new(big.Int).Lsh(one, 128),
+		new(big.Int).Exp(big.NewInt(base), big.NewInt(22), nil),
+	} {
+		values = append(values, new(big.Int).Sub(p, one), p, new(big.Int).Add(p, one))
+	}
+
+	for _, n := range values {
+		s := e.EncodeBigInt(new(big.Int).Set(n))
+		v, err := e.DecodeToBigInt(s)
+		if err != nil {
+			return ErrSelfTest{fmt.Errorf("Self test failed decoding %s encoded as %q: %w", n, s, err)}
+		}
+		if v.Cmp(n) != 0 {
+			return ErrSelfTest{fmt.Errorf("Self test failed, %s encoded as %q decoded as %s", n, s, v)}
+		}
+	}
+
+	return nil
+}
+
+// bigTestVectors returns the big integer test vectors, for TestVectors
+func (e *Encoding) bigTestVectors() []TestVector {
+	one := big.NewInt(1)
+
+	var vectors []TestVector
+	for _, p := range []struct {
+		n    *big.Int
+		note string
+	}{
+		{new(big.Int).Lsh(one, 64), "uint64"},
+		{new(big.Int).Lsh(one, 127), "int128"},
+		{new(big.Int).Lsh(one, 128), "uint128"},
+	} {
+		for _, n := range []*big.Int{new(big.Int).Sub(p.n, one), p.n} {
+			vectors = append(vectors, TestVector{"bigint", n.String(), e.EncodeBigInt(new(big.Int).Set(n)), ""})
+		}
+		vectors[len(vectors)-2].Note = "max " + p.note
+	}
+
+	return vectors
+}
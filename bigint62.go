@@ -0,0 +1,66 @@
+//go:build !base62_nobig
+
+package base62
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// BigInt62 is an arbitrary precision integer which marshals as its base62
+// encoding with the StdEncoding, so 128 bit identifiers are exchanged in
+// JSON as strings without the loss of precision of JavaScript numbers. As
+// with big.Int, fields should be pointers, *BigInt62
+type BigInt62 big.Int
+
+// NewBigInt62 returns a BigInt62 set to a copy of x
+func NewBigInt62(x *big.Int) *BigInt62 {
+	return (*BigInt62)(new(big.Int).Set(x))
+}
+
+// Int returns n as a big.Int, sharing its value
+func (n *BigInt62) Int() *big.Int {
+	return (*big.Int)(n)
+}
+
+// String returns the base62 encoding of n
+func (n *BigInt62) String() string {
+	return StdEncoding.EncodeBigInt(new(big.Int).Set(n.Int()))
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (n *BigInt62) MarshalText() ([]byte, error) {
+	return StdEncoding.AppendBigInt(nil, n.Int()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (n *BigInt62) UnmarshalText(b []byte) error {
+	v, err := DecodeToBigInt(string(b))
+	if err != nil {
+		return err
+	}
+
+	n.Int().Set(v)
+	return nil
+}
+
+// Scan implements fmt.Scanner, reading the base62 encoding by the %v or %s
+// verbs of fmt.Sscanf and the like
+func (n *BigInt62) Scan(state fmt.ScanState, verb rune) error {
+	s, err := scanToken(state, verb)
+	if err != nil {
+		return err
+	}
+	return n.UnmarshalText([]byte(s))
+}
+
+// Set implements flag.Value, so that command line flags parse to a
+// BigInt62, with flag.Var
+func (n *BigInt62) Set(s string) error {
+	return n.UnmarshalText([]byte(s))
+}
+
+// Type names the value in usage messages, as used by pflag
+func (*BigInt62) Type() string {
+	return "base62"
+}
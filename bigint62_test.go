@@ -0,0 +1,84 @@
+//go:build !base62_nobig
+
+package base62
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBigInt62JSON(t *testing.T) {
+	type record struct {
+		ID     *BigInt62 `json:"id"`
+		Parent *BigInt62 `json:"parent"`
+	}
+
+	x, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+	r := record{ID: NewBigInt62(x)}
+
+	b, err := json.Marshal(r)
+	require.NoError(t, err)
+	t.Logf("Marshalled %s", b)
+	assert.Equal(t, `{"id":"7n42DGM5Tflk9n8mt7Fhc7","parent":null}`, string(b))
+
+	// The original value is unchanged by marshalling
+	assert.Equal(t, "340282366920938463463374607431768211455", x.String())
+
+	var v record
+	require.NoError(t, json.Unmarshal(b, &v))
+	assert.Equal(t, 0, x.Cmp(v.ID.Int()))
+	assert.Nil(t, v.Parent)
+
+	err = json.Unmarshal([]byte(`{"id":"7n42-DGM5"}`), &v)
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestBigInt62Negative(t *testing.T) {
+	n := NewBigInt62(big.NewInt(-62))
+
+	b, err := json.Marshal(n)
+	require.NoError(t, err)
+	assert.Equal(t, `"-10"`, string(b))
+
+	var v BigInt62
+	require.NoError(t, json.Unmarshal(b, &v))
+	assert.Equal(t, int64(-62), v.Int().Int64())
+}
+
+func TestBigInt62String(t *testing.T) {
+	n := NewBigInt62(big.NewInt(4815162342))
+	assert.Equal(t, "5Frvgk", n.String())
+	assert.Equal(t, int64(4815162342), n.Int().Int64())
+}
+
+func TestBigInt62Flag(t *testing.T) {
+	var (
+		id BigInt62
+		fs = flag.NewFlagSet("test", flag.ContinueOnError)
+	)
+	fs.SetOutput(io.Discard)
+	fs.Var(&id, "id", "record `ID`")
+
+	require.NoError(t, fs.Parse([]string{"-id", "7n42DGM5Tflk9n8mt7Fhc7"}))
+	assert.Equal(t, "340282366920938463463374607431768211455", id.Int().String())
+
+	assert.Error(t, fs.Parse([]string{"-id", "7n42-DGM5"}))
+	assert.Equal(t, "base62", id.Type())
+}
+
+func TestBigInt62Scan(t *testing.T) {
+	var n BigInt62
+	_, err := fmt.Sscanf("key 7n42DGM5Tflk9n8mt7Fhc7", "key %v", &n)
+	require.NoError(t, err)
+	assert.Equal(t, "340282366920938463463374607431768211455", n.Int().String())
+
+	_, err = fmt.Sscan("7n42-DGM5", &n)
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
@@ -0,0 +1,274 @@
+//go:build !base62_nobig
+
+package base62
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var bigTestcases = []struct {
+	num     string
+	encoded string
+}{
+	{"1", "1"},
+	{"9", "9"},
+	{"10", "A"},
+	{"35", "Z"},
+	{"36", "a"},
+	{"61", "z"},
+	{"62", "10"},
+	{"99", "1b"},
+	{"3844", "100"},
+	{"3860", "10G"},
+	{"4815162342", "5Frvgk"},
+
+	{"9223372036854775807", "AzL8n0Y58m7"},       // max signed int64
+	{"9223372036854775809", "AzL8n0Y58m9"},       // beyond int64
+	{"9223372036854775861", "AzL8n0Y58mz"},       //
+	{"18446744073709551615", "LygHa16AHYF"},      // max uint64
+	{"571849066284996100034", "AzL8n0Y58m70"},    // max int64 * 62
+	{"35454642109669758202168", "AzL8n0Y58m70y"}, // (max int64 * 62^2) + 60
+
+	{"24467927614188555520896788267013", "8HFaR8qWtRlGDHnO57"}, // a few boundary flake id tests
+	{"24467927614170108776823078715395", "8HFaR8qAulTgCBd6Wp"},
+	{"24467927614170108776823078715394", "8HFaR8qAulTgCBd6Wo"},
+	{"24467927614170108776823078715393", "8HFaR8qAulTgCBd6Wn"},
+	{"24467927614170108776823078715392", "8HFaR8qAulTgCBd6Wm"},
+
+	{"170141183460469231731687303715884105727", "3tX16dB2jpss4tZORYcqo3"}, // max signed 128bit int
+	{"170141183460469231731687303715884105757", "3tX16dB2jpss4tZORYcqoX"}, // max signed 128bit int + 30
+	{"340282366920938463463374607431768211455", "7n42DGM5Tflk9n8mt7Fhc7"}, // max unsigned 128bit int
+
+	{"2707803647802660400290261537185326956543", "zzzzzzzzzzzzzzzzzzzzzz"}, // max 22 character when encoded
+}
+
+func TestEncodeBigInt(t *testing.T) {
+	for _, tc := range bigTestcases {
+		var (
+			n  = new(big.Int)
+			ok bool
+		)
+
+		n, ok = n.SetString(tc.num, 10)
+		require.True(t, ok)
+
+		v := EncodeBigInt(n)
+		t.Logf("Encoded %v as %s", tc.num, v)
+		assert.Equal(t, tc.encoded, v)
+	}
+}
+
+func BenchmarkEncodeBigIntVeryLong(b *testing.B) {
+	var (
+		v = new(big.Int)
+		s string
+	)
+	v.SetString("340282366920938463463374607431768211455", 10)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		s = EncodeBigInt(v)
+	}
+	result = s
+}
+
+func TestDecodeToBigInt(t *testing.T) {
+	for _, tc := range bigTestcases {
+		v, err := DecodeToBigInt(tc.encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Decoded %v to %s", tc.encoded, v.String())
+		assert.Equal(t, tc.num, v.String())
+	}
+}
+
+func TestPaddedDecodeToBigInt(t *testing.T) {
+	testcases := []struct {
+		encoded string
+		result  string
+	}{
+		{"0000000000000000000000000000000000005Frvgk", "4815162342"},
+		{"000000000000000000003tX16dB2jpss4tZORYcqoX", "170141183460469231731687303715884105757"},
+		{"000000000000000000007n42DGM5Tflk9n8mt7Fhc7", "340282366920938463463374607431768211455"},
+	}
+
+	for _, tc := range testcases {
+		v, err := DecodeToBigInt(tc.encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Decoded %s to %v", tc.encoded, v)
+		assert.Equal(t, tc.result, v.String())
+	}
+}
+
+func TestMustDecodeToBigInt(t *testing.T) {
+	assert.Equal(t, "340282366920938463463374607431768211455", MustDecodeToBigInt("7n42DGM5Tflk9n8mt7Fhc7").String())
+	assert.Panics(t, func() { MustDecodeToBigInt("7n42-DGM5") })
+}
+
+func TestNegativeBigInt(t *testing.T) {
+	testcases := []struct {
+		num     string
+		encoded string
+	}{
+		{"-1", "-1"},
+		{"-62", "-10"},
+		{"-4815162342", "-5Frvgk"},
+		{"-340282366920938463463374607431768211455", "-7n42DGM5Tflk9n8mt7Fhc7"},
+	}
+
+	for _, tc := range testcases {
+		n, ok := new(big.Int).SetString(tc.num, 10)
+		require.True(t, ok)
+
+		v := EncodeBigInt(n)
+		t.Logf("Encoded %v as %s", tc.num, v)
+		assert.Equal(t, tc.encoded, v)
+		assert.Equal(t, tc.num, n.String())
+		assert.Equal(t, len(tc.encoded), EncodedLenBig(n))
+
+		d, err := DecodeToBigInt(v)
+		require.NoError(t, err)
+		assert.Equal(t, tc.num, d.String())
+	}
+
+	// The sign marker and padding of the encoding apply
+	e := NewStdEncoding().Option(Sign('~'), Padding(8))
+	assert.Equal(t, "~05Frvgk", e.EncodeBigInt(big.NewInt(-4815162342)))
+	d, err := e.DecodeToBigInt("~05Frvgk")
+	require.NoError(t, err)
+	assert.Equal(t, "-4815162342", d.String())
+
+	_, err = DecodeToBigInt("-")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeToBigInt("")
+	assert.IsType(t, ErrEmptyInput{}, err)
+
+	_, err = DecodeToBigInt("--5")
+	var invalid ErrInvalidCharacter
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, 1, invalid.Offset)
+
+	// Alphabets including the default marker encode with their Sign
+	dash := NewEncoding("-123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz", Sign('~'))
+	assert.Equal(t, "~5", dash.EncodeBigInt(big.NewInt(-5)))
+}
+
+func TestEncodedLenBig(t *testing.T) {
+	for _, tc := range bigTestcases {
+		n, ok := new(big.Int).SetString(tc.num, 10)
+		require.True(t, ok)
+		assert.Equal(t, len(tc.encoded), EncodedLenBig(n), tc.num)
+	}
+
+	assert.Equal(t, 1, EncodedLenBig(big.NewInt(0)))
+	assert.Equal(t, 2, EncodedLenBig(big.NewInt(-5)))
+
+	// Check either side of each change in length
+	p := big.NewInt(1)
+	for l := 1; l < 200; l++ {
+		below := new(big.Int).Sub(p, big.NewInt(1))
+		assert.Equal(t, len(EncodeBigInt(new(big.Int).Set(below))), EncodedLenBig(below))
+		assert.Equal(t, l, EncodedLenBig(p))
+
+		p.Mul(p, big.NewInt(base))
+	}
+}
+
+func TestBigTestVectors(t *testing.T) {
+	var count int
+	for _, tc := range StdEncoding.TestVectors().Vectors {
+		if tc.Kind != "bigint" {
+			continue
+		}
+		count++
+
+		n, err := DecodeToBigInt(tc.Encoded)
+		require.NoError(t, err)
+		assert.Equal(t, tc.Input, n.String())
+	}
+	assert.Equal(t, 6, count)
+}
+
+func TestGroupBigInt(t *testing.T) {
+	e := NewStdEncoding().Option(Group(4, "-"))
+	n, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+
+	s := e.EncodeBigInt(new(big.Int).Set(n))
+	assert.Equal(t, "7n42-DGM5-Tflk-9n8m-t7Fh-c7", s)
+
+	v, err := e.DecodeToBigInt(s)
+	require.NoError(t, err)
+	assert.Equal(t, n, v)
+}
+
+func TestAppendBigInt(t *testing.T) {
+	n, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+
+	v := AppendBigInt([]byte("id:"), n)
+	assert.Equal(t, "id:7n42DGM5Tflk9n8mt7Fhc7", string(v))
+
+	// The value is not consumed by encoding
+	assert.Equal(t, "340282366920938463463374607431768211455", n.String())
+}
+
+func TestEncodeBigIntZero(t *testing.T) {
+	assert.Equal(t, "0", EncodeBigInt(big.NewInt(0)))
+	assert.Equal(t, "", NewStdEncoding().Option(EmptyZero()).EncodeBigInt(big.NewInt(0)))
+}
+
+func TestMaxDecodeLenBigInt(t *testing.T) {
+	e := NewStdEncoding().Option(MaxDecodeLen(22))
+
+	n, err := e.DecodeToBigInt("7n42DGM5Tflk9n8mt7Fhc7")
+	require.NoError(t, err)
+	assert.Equal(t, "340282366920938463463374607431768211455", n.String())
+
+	_, err = e.DecodeToBigInt(strings.Repeat("z", 1<<20))
+	assert.IsType(t, ErrTooLong{}, err)
+}
+
+func TestStrictBigInt(t *testing.T) {
+	e := NewStdEncoding().Option(Strict())
+
+	n, err := e.DecodeToBigInt("7n42DGM5Tflk9n8mt7Fhc7")
+	require.NoError(t, err)
+	assert.Equal(t, "340282366920938463463374607431768211455", n.String())
+
+	_, err = e.DecodeToBigInt("007n42DGM5Tflk9n8mt7Fhc7")
+	assert.IsType(t, ErrNotCanonical{}, err)
+}
+
+func TestLuhnBigInt(t *testing.T) {
+	e := NewStdEncoding().Option(Luhn())
+	n, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+
+	v := e.EncodeBigInt(new(big.Int).Set(n))
+	assert.Equal(t, "7n42DGM5Tflk9n8mt7Fhc7p", v)
+
+	d, err := e.DecodeToBigInt(v)
+	require.NoError(t, err)
+	assert.Equal(t, n, d)
+
+	_, err = e.DecodeToBigInt("7n42DGM5Tflk9n8mt7Fhc7q")
+	assert.IsType(t, ErrChecksum{}, err)
+}
+
+func TestLetterFirstBigInt(t *testing.T) {
+	e := LetterFirstEncoding
+
+	v := e.EncodeBigInt(big.NewInt(52))
+	assert.Equal(t, "A0", v)
+
+	d, err := e.DecodeToBigInt(v)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(52), d)
+}
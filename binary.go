@@ -0,0 +1,49 @@
+package base62
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// EncodeBinary returns the base62 encoding of the binary representation of
+// v using the StdEncoding, see Encoding.EncodeBinary
+func EncodeBinary(v any, order binary.ByteOrder) (string, error) {
+	return StdEncoding.EncodeBinary(v, order)
+}
+
+// DecodeBinary decodes a value encoded by EncodeBinary into v using the
+// StdEncoding, see Encoding.DecodeBinary
+func DecodeBinary(s string, order binary.ByteOrder, v any) error {
+	return StdEncoding.DecodeBinary(s, order, v)
+}
+
+// EncodeBinary writes v with binary.Write in the given byte order and
+// returns the base62 encoding of the result. v must be a fixed size value,
+// such as a struct of fixed size fields, and the output has a fixed width
+// for any value of its type
+func (e *Encoding) EncodeBinary(v any, order binary.ByteOrder) (string, error) {
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, order, v); err != nil {
+		return "", ErrInvalidValue{fmt.Errorf("Cannot encode %T: %w", v, err)}
+	}
+
+	return e.encodeFixed(buf.Bytes()), nil
+}
+
+// DecodeBinary decodes a value encoded by EncodeBinary, reading it into v
+// with binary.Read in the given byte order. v must be a pointer to a value
+// of the same type that was encoded
+func (e *Encoding) DecodeBinary(s string, order binary.ByteOrder, v any) error {
+	size := binary.Size(v)
+	if size < 0 {
+		return ErrInvalidValue{fmt.Errorf("Cannot decode into %T, which is not of fixed size", v)}
+	}
+
+	b := make([]byte, size)
+	if err := e.decodeFixed(s, b); err != nil {
+		return err
+	}
+
+	return binary.Read(bytes.NewReader(b), order, v)
+}
@@ -0,0 +1,98 @@
+package base62
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/mattheath/base62/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRecord struct {
+	Shard   uint16
+	ID      uint64
+	Created int32
+	Flags   [3]byte
+}
+
+func TestEncodeBinary(t *testing.T) {
+	r := testRecord{Shard: 7, ID: 4815162342, Created: -1, Flags: [3]byte{1, 0, 1}}
+
+	for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+		v, err := EncodeBinary(r, order)
+		require.NoError(t, err)
+		t.Logf("Encoded %+v as %s using %v", r, v, order)
+		assert.Len(t, v, core.EncodedLen(binary.Size(r)))
+
+		var decoded testRecord
+		require.NoError(t, DecodeBinary(v, order, &decoded))
+		assert.Equal(t, r, decoded)
+	}
+}
+
+func TestEncodeBinaryByteOrder(t *testing.T) {
+	v, err := EncodeBinary(uint32(1), binary.BigEndian)
+	require.NoError(t, err)
+	assert.Equal(t, "000001", v)
+
+	v, err = EncodeBinary(uint32(1), binary.LittleEndian)
+	require.NoError(t, err)
+	assert.Equal(t, "018OWG", v)
+}
+
+func TestEncodeBinaryZero(t *testing.T) {
+	v, err := EncodeBinary(testRecord{}, binary.BigEndian)
+	require.NoError(t, err)
+	assert.Equal(t, "00000000000000000000000", v)
+
+	decoded := testRecord{Shard: 1}
+	require.NoError(t, DecodeBinary(v, binary.BigEndian, &decoded))
+	assert.Equal(t, testRecord{}, decoded)
+}
+
+func TestEncodeBinaryInvalid(t *testing.T) {
+	_, err := EncodeBinary("variable", binary.BigEndian)
+	assert.IsType(t, ErrInvalidValue{}, err)
+
+	var s []int
+	err = DecodeBinary("000001", binary.BigEndian, &s)
+	assert.IsType(t, ErrInvalidValue{}, err)
+
+	var n uint32
+	err = DecodeBinary("00001", binary.BigEndian, &n)
+	assert.IsType(t, ErrInvalidLength{}, err)
+}
+
+func TestByteOrderOption(t *testing.T) {
+	le := NewStdEncoding().Option(ByteOrder(binary.LittleEndian))
+
+	v := EncodeArrayWith(le, [4]byte{1, 0, 0, 0})
+	assert.Equal(t, "000001", v)
+	assert.Equal(t, EncodeArray([4]byte{0, 0, 0, 1}), v)
+
+	a, err := DecodeArrayWith[[4]byte](le, v)
+	require.NoError(t, err)
+	assert.Equal(t, [4]byte{1, 0, 0, 0}, a)
+
+	// Writing little-endian and interpreting as little-endian
+	// matches the numeric encoding of the value
+	v, err = le.EncodeBinary(uint32(4815162), binary.LittleEndian)
+	require.NoError(t, err)
+	assert.Equal(t, "00KCdu", v)
+
+	var n uint32
+	require.NoError(t, le.DecodeBinary(v, binary.LittleEndian, &n))
+	assert.Equal(t, uint32(4815162), n)
+
+	u := [16]byte{0xde, 0xad, 0xbe, 0xef, 15: 0x01}
+	var dst [22]byte
+	le.EncodeUUIDTo(&dst, u)
+	assert.Equal(t, EncodeArrayWith(le, u), string(dst[:]))
+	assert.NoError(t, le.SelfTest())
+
+	assert.True(t, NewStdEncoding().Option(ByteOrder(binary.BigEndian)).Equal(StdEncoding))
+	spec, err := le.Spec()
+	require.NoError(t, err)
+	assert.Equal(t, "std;le", spec)
+}
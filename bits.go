@@ -0,0 +1,135 @@
+//go:build !base62_nobig
+
+package base62
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// MaxBitsetLen is the largest bit count of an encoded bitset, which limits
+// the memory allocated when decoding untrusted input
+const MaxBitsetLen = 1 << 16
+
+// EncodeBits returns the base62 encoding of a set of bits using the
+// StdEncoding, see Encoding.EncodeBits
+func EncodeBits(bits []bool) (string, error) {
+	return StdEncoding.EncodeBits(bits)
+}
+
+// DecodeBits decodes a set of bits encoded by EncodeBits using the StdEncoding
+func DecodeBits(s string) ([]bool, error) {
+	return StdEncoding.DecodeBits(s)
+}
+
+// EncodeBitset returns the base62 encoding of an n bit mask using the
+// StdEncoding, see Encoding.EncodeBitset
+func EncodeBitset(mask *big.Int, n int) (string, error) {
+	return StdEncoding.EncodeBitset(mask, n)
+}
+
+// DecodeBitset decodes a bit mask encoded by EncodeBitset using the
+// StdEncoding, returning the mask and its bit count
+func DecodeBitset(s string) (*big.Int, int, error) {
+	return StdEncoding.DecodeBitset(s)
+}
+
+// EncodeBits returns the base62 encoding of a set of bits, such as
+// permission masks or feature flags. The number of bits is preserved,
+// so trailing unset bits are restored when decoded. An ErrInvalidLength is
+// returned for more than MaxBitsetLen bits
+func (e *Encoding) EncodeBits(bits []bool) (string, error) {
+	mask := new(big.Int)
+	for i, v := range bits {
+		if v {
+			mask.SetBit(mask, i, 1)
+		}
+	}
+
+	return e.EncodeBitset(mask, len(bits))
+}
+
+// DecodeBits decodes a set of bits encoded by EncodeBits
+func (e *Encoding) DecodeBits(s string) ([]bool, error) {
+	mask, n, err := e.DecodeBitset(s)
+	if err != nil {
+		return nil, err
+	}
+
+	bits := make([]bool, n)
+	for i := range bits {
+		bits[i] = mask.Bit(i) == 1
+	}
+
+	return bits, nil
+}
+
+// EncodeBitset returns the base62 encoding of an n bit mask, where bit i of
+// the mask holds the flag at index i. The bit count is encoded as a length
+// prefixed value ahead of the mask itself, so that it is preserved. Both
+// are written as plain digits, whichever of WithMask, KnuthHash, Luhn or
+// ZigZag the encoding sets. An ErrInvalidLength is returned if n is
+// negative or exceeds the MaxBitsetLen, an ErrInvalidValue if the mask is
+// negative, and an ErrOverflow if it does not fit within n bits
+func (e *Encoding) EncodeBitset(mask *big.Int, n int) (string, error) {
+	if n < 0 || n > MaxBitsetLen {
+		return "", ErrInvalidLength{fmt.Errorf("Bit count %d must be between 0 and %d", n, MaxBitsetLen)}
+	}
+	if mask.Sign() < 0 {
+		return "", ErrInvalidValue{fmt.Errorf("Bit mask %s is negative", mask)}
+	}
+	if mask.BitLen() > n {
+		return "", ErrOverflow{fmt.Errorf("Bit mask exceeds %d bits", n)}
+	}
+
+	count := e.encodeUint64(uint64(n))
+	return string(e.encode[len(count)]) + count + e.encodeBigInt(new(big.Int).Set(mask)), nil
+}
+
+// DecodeBitset decodes a bit mask encoded by EncodeBitset,
+// returning the mask and its bit count
+func (e *Encoding) DecodeBitset(s string) (*big.Int, int, error) {
+	if len(s) == 0 {
+		return nil, 0, ErrInvalidLength{fmt.Errorf("Bitset missing bit count")}
+	}
+
+	// Read the length prefixed bit count
+	l := e.index(s[0])
+	if l == -1 {
+		return nil, 0, e.invalidCharacter(s, 0)
+	}
+	if 1+l > len(s) {
+		return nil, 0, ErrInvalidLength{fmt.Errorf("Bitset truncated, expected %d characters of bit count", l)}
+	}
+
+	// A zero bit count or mask is encoded as no digits at all
+	var (
+		n   uint64
+		err error
+	)
+	if l > 0 {
+		if n, err = e.decodeUint64(s[1 : 1+l]); err != nil {
+			return nil, 0, err
+		}
+	}
+	if n > MaxBitsetLen {
+		return nil, 0, ErrOverflow{fmt.Errorf("Bit count %d exceeds the maximum of %d", n, MaxBitsetLen)}
+	}
+
+	// The remainder is the mask, which must fit within the bit count, so
+	// is checked to be no wider before decoding
+	mask := new(big.Int)
+	if len(s)-1-l > bitWidth(int(n)) {
+		return nil, 0, ErrOverflow{fmt.Errorf("Bit mask exceeds %d bits", n)}
+	}
+	if len(s) > 1+l {
+		if mask, err = e.decodeBigInt(s, 1+l); err != nil {
+			return nil, 0, err
+		}
+	}
+	if uint64(mask.BitLen()) > n {
+		return nil, 0, ErrOverflow{fmt.Errorf("Bit mask exceeds %d bits", n)}
+	}
+
+	return mask, int(n), nil
+}
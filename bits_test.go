@@ -0,0 +1,134 @@
+//go:build !base62_nobig
+
+package base62
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeBits(t *testing.T) {
+	testcases := []struct {
+		bits    []bool
+		encoded string
+	}{
+		{nil, "0"},
+		{[]bool{false}, "11"},
+		{[]bool{true}, "111"},
+		{[]bool{false, false, false}, "13"},
+		{[]bool{true, false, true, false, false}, "155"},
+		{[]bool{false, false, false, false, false, false, true}, "17" + "12"},
+	}
+
+	for _, tc := range testcases {
+		v, err := EncodeBits(tc.bits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Encoded %v as %s", tc.bits, v)
+		assert.Equal(t, tc.encoded, v)
+
+		bits, err := DecodeBits(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, len(tc.bits), len(bits))
+		for i := range tc.bits {
+			assert.Equal(t, tc.bits[i], bits[i])
+		}
+	}
+}
+
+func TestEncodeBitset(t *testing.T) {
+	mask, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+
+	v, err := EncodeBitset(mask, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "23E"+"7n42DGM5Tflk9n8mt7Fhc7", v)
+	assert.Equal(t, "340282366920938463463374607431768211455", mask.String())
+
+	decoded, n, err := DecodeBitset(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 200, n)
+	assert.Equal(t, 0, mask.Cmp(decoded))
+}
+
+func TestEncodeBitsetOptions(t *testing.T) {
+	encodings := []*Encoding{
+		NewStdEncoding().Option(WithMask(7)),
+		NewStdEncoding().Option(Luhn()),
+		NewStdEncoding().Option(KnuthHash()),
+		NewStdEncoding().Option(ZigZag(), Strict()),
+		NewStdEncoding().Option(Padding(8)),
+	}
+
+	// Bitsets encode their plain digits, however the encoding maps values
+	for _, e := range encodings {
+		for _, bits := range [][]bool{nil, {true}, {false, true}, {true, false, true, true}} {
+			v, err := e.EncodeBits(bits)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Logf("Encoded %v as %s with %v", bits, v, e)
+
+			decoded, err := e.DecodeBits(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, len(bits), len(decoded))
+			for i := range bits {
+				assert.Equal(t, bits[i], decoded[i])
+			}
+		}
+	}
+}
+
+func TestEncodeBitsetInvalid(t *testing.T) {
+	_, err := EncodeBitset(big.NewInt(8), 3)
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = EncodeBitset(big.NewInt(-1), 3)
+	assert.IsType(t, ErrInvalidValue{}, err)
+
+	_, err = EncodeBitset(big.NewInt(0), -1)
+	assert.IsType(t, ErrInvalidLength{}, err)
+}
+
+func TestDecodeBitsetInvalid(t *testing.T) {
+	_, _, err := DecodeBitset("")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, _, err = DecodeBitset("3")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, _, err = DecodeBitset("-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+
+	_, _, err = DecodeBitset("138")
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = DecodeBits("Azzzzzzzzzz")
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = DecodeBits("3H33" + "0")
+	assert.IsType(t, ErrOverflow{}, err)
+
+	bits, err := DecodeBits("3H32" + "0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, MaxBitsetLen, len(bits))
+
+	_, err = EncodeBits(make([]bool, MaxBitsetLen+1))
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	// Masks wider than the bit count are rejected before decoding
+	_, _, err = DecodeBitset("12" + "zzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzz")
+	assert.IsType(t, ErrOverflow{}, err)
+}
@@ -0,0 +1,124 @@
+package base62
+
+import (
+	"fmt"
+	"math"
+)
+
+// EncodeBitstream returns the base62 encoding of the first nbits bits of b
+// using the StdEncoding, see Encoding.EncodeBitstream
+func EncodeBitstream(b []byte, nbits int) (string, error) {
+	return StdEncoding.EncodeBitstream(b, nbits)
+}
+
+// DecodeBitstream decodes a bitstream encoded by EncodeBitstream using the
+// StdEncoding, returning the bits and their exact length
+func DecodeBitstream(s string) ([]byte, int, error) {
+	return StdEncoding.DecodeBitstream(s)
+}
+
+// EncodeBitstream returns the base62 encoding of the first nbits bits of b,
+// most significant bit first, for bit strings which are not byte aligned
+// such as packed variable width fields. The bit length is encoded as a
+// length prefixed value ahead of the bits, which are encoded in the fewest
+// characters able to hold any value of that length, so decoding restores
+// the bit length exactly. An ErrInvalidLength is returned if nbits is
+// negative or b holds fewer than nbits bits
+func (e *Encoding) EncodeBitstream(b []byte, nbits int) (string, error) {
+	if nbits < 0 || nbits > len(b)*8 {
+		return "", ErrInvalidLength{fmt.Errorf("%d bytes do not hold %d bits", len(b), nbits)}
+	}
+
+	// Take the whole bytes holding our bits, then shift them down so
+	// the last bit is least significant, discarding any trailing bits
+	n := append([]byte(nil), b[:(nbits+7)/8]...)
+	shiftRight(n, uint(len(n)*8-nbits))
+
+	count := e.encodeUint64(uint64(nbits))
+	out := make([]byte, 1+len(count)+bitWidth(nbits))
+	out[0] = e.encode[len(count)]
+	copy(out[1:], count)
+	// The value fits the width of the bits, so only leading zero digits of
+	// the width of the bytes are dropped
+	digits := e.raw.AppendBytes(nil, n)
+	copy(out[1+len(count):], digits[len(digits)-bitWidth(nbits):])
+
+	return string(out), nil
+}
+
+// DecodeBitstream decodes a bitstream encoded by EncodeBitstream, returning
+// the bits, most significant bit first and with any trailing bits of the
+// final byte zero, along with the exact bit length
+func (e *Encoding) DecodeBitstream(s string) ([]byte, int, error) {
+	if len(s) == 0 {
+		return nil, 0, ErrInvalidLength{fmt.Errorf("Bitstream missing bit length")}
+	}
+
+	// Read the length prefixed bit length
+	l := e.index(s[0])
+	if l == -1 {
+		return nil, 0, e.invalidCharacter(s, 0)
+	}
+	if 1+l > len(s) {
+		return nil, 0, ErrInvalidLength{fmt.Errorf("Bitstream truncated, expected %d characters of bit length", l)}
+	}
+
+	count, err := e.decodeUint64(s[1 : 1+l])
+	if err != nil {
+		return nil, 0, err
+	}
+	if w := bitWidth(int(count)); count > math.MaxInt32 || len(s)-1-l != w {
+		return nil, 0, ErrInvalidLength{fmt.Errorf("Bitstream of %d bits must be %d characters, got %d", count, w, len(s)-1-l)}
+	}
+
+	d, err := e.digits(s[1+l:])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Decode the digits into the bytes, then check the value fits within
+	// the bit length before shifting it back up into place
+	var (
+		nbits = int(count)
+		b     = make([]byte, (nbits+7)/8)
+		pad   = uint(len(b)*8 - nbits)
+	)
+	if !e.decodeDigits(b, d) || pad > 0 && b[0]>>(8-pad) != 0 {
+		return nil, 0, ErrOverflow{fmt.Errorf("Bitstream value overflows %d bits", nbits)}
+	}
+	shiftLeft(b, pad)
+
+	return b, nbits, nil
+}
+
+// bitWidth returns the number of base62 characters needed to
+// represent any value of n bits
+func bitWidth(n int) int {
+	return int(math.Ceil(float64(n) / math.Log2(base)))
+}
+
+// shiftRight shifts big-endian bytes right by fewer than 8 bits
+func shiftRight(b []byte, n uint) {
+	if n == 0 {
+		return
+	}
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i] >>= n
+		if i > 0 {
+			b[i] |= b[i-1] << (8 - n)
+		}
+	}
+}
+
+// shiftLeft shifts big-endian bytes left by fewer than 8 bits
+func shiftLeft(b []byte, n uint) {
+	if n == 0 {
+		return
+	}
+	for i := range b {
+		b[i] <<= n
+		if i < len(b)-1 {
+			b[i] |= b[i+1] >> (8 - n)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package base62
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeBitstream(t *testing.T) {
+	testcases := []struct {
+		b       []byte
+		nbits   int
+		encoded string
+		decoded []byte
+	}{
+		{nil, 0, "0", []byte{}},
+		{[]byte{0x80}, 1, "111", []byte{0x80}},
+		{[]byte{0x00}, 1, "110", []byte{0x00}},
+		{[]byte{0xff}, 3, "137", []byte{0xe0}},
+		{[]byte{0xa5, 0xff}, 12, "1C0gp", []byte{0xa5, 0xf0}},
+		{[]byte{0x00, 0x01}, 16, "1G001", []byte{0x00, 0x01}},
+	}
+
+	for _, tc := range testcases {
+		v, err := EncodeBitstream(tc.b, tc.nbits)
+		require.NoError(t, err)
+		t.Logf("Encoded %d bits of %x as %s", tc.nbits, tc.b, v)
+		assert.Equal(t, tc.encoded, v)
+
+		b, n, err := DecodeBitstream(v)
+		require.NoError(t, err)
+		assert.Equal(t, tc.nbits, n)
+		assert.Equal(t, tc.decoded, b)
+	}
+}
+
+func TestEncodeBitstreamRoundTrip(t *testing.T) {
+	for nbits := 0; nbits <= 200; nbits++ {
+		b := make([]byte, (nbits+7)/8)
+		_, err := rand.Read(b)
+		require.NoError(t, err)
+
+		// Trailing bits aren't preserved
+		if nbits%8 != 0 {
+			b[len(b)-1] &^= 0xff >> (nbits % 8)
+		}
+
+		v, err := EncodeBitstream(b, nbits)
+		require.NoError(t, err)
+		decoded, n, err := DecodeBitstream(v)
+		require.NoError(t, err)
+		assert.Equal(t, nbits, n)
+		assert.Equal(t, b, decoded)
+	}
+}
+
+func TestEncodeBitstreamInvalid(t *testing.T) {
+	_, err := EncodeBitstream([]byte{0xff}, 9)
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = EncodeBitstream([]byte{0xff}, -1)
+	assert.IsType(t, ErrInvalidLength{}, err)
+}
+
+func TestDecodeBitstreamInvalid(t *testing.T) {
+	_, _, err := DecodeBitstream("")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, _, err = DecodeBitstream("2")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, _, err = DecodeBitstream("13")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, _, err = DecodeBitstream("138")
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, _, err = DecodeBitstream("13-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
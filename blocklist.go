@@ -0,0 +1,71 @@
+package base62
+
+import (
+	"strings"
+)
+
+// DefaultBlocklist is a built in list of offensive words, for use with
+// Blocklist, which catches the most common cases in English
+var DefaultBlocklist = []string{
+	"anal", "anus", "arse", "ass", "bitch", "boob", "butt", "cock", "coon",
+	"crap", "cum", "cunt", "dick", "dildo", "dyke", "fag", "fuck", "gay",
+	"homo", "jizz", "kike", "kkk", "nazi", "nigg", "penis", "piss", "poop",
+	"porn", "pussy", "rape", "sex", "shit", "slut", "spic", "tit", "twat",
+	"vagina", "wank", "whore",
+}
+
+// Blocklist sets generated IDs to be screened for the given words, such as
+// the DefaultBlocklist, so codes printed on customer facing URLs don't
+// contain offensive words. Random strings, tokens and time prefixed IDs are
+// regenerated, and Counters skip over values, whenever an encoding contains
+// a word regardless of case, including where digits stand in for similar
+// letters such as sh1t. Encoding and decoding values directly is unaffected
+func Blocklist(words ...string) option {
+	return func(e *Encoding) {
+		var list []string
+		for _, w := range words {
+			if w = normaliseBlocked(w); w != "" {
+				list = append(list, w)
+			}
+		}
+
+		e.blocklist = strings.Join(list, blocklistSep)
+	}
+}
+
+// blocklistSep separates the words of the blocklist, as words containing it
+// could never match the printable characters of an alphabet
+const blocklistSep = "\x00"
+
+// blocked returns whether s contains any word of the blocklist
+func (e *Encoding) blocked(s string) bool {
+	return e.blockedEnd(s) != -1
+}
+
+// blockedEnd returns the offset just past the first blocked word to end
+// within s, or -1 if s contains none
+func (e *Encoding) blockedEnd(s string) int {
+	if e.blocklist == "" {
+		return -1
+	}
+
+	// Normalising preserves offsets, as it only replaces single characters
+	s = normaliseBlocked(s)
+	end := -1
+	for list := e.blocklist; list != ""; {
+		var w string
+		w, list, _ = strings.Cut(list, blocklistSep)
+		if i := strings.Index(s, w); i != -1 && (end == -1 || i+len(w) < end) {
+			end = i + len(w)
+		}
+	}
+	return end
+}
+
+// leetspeak maps digits onto the letters they are used in place of
+var leetspeak = strings.NewReplacer("0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "8", "b")
+
+// normaliseBlocked lowercases s and replaces digits standing in for letters
+func normaliseBlocked(s string) string {
+	return leetspeak.Replace(strings.ToLower(s))
+}
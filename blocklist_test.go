@@ -0,0 +1,74 @@
+package base62
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlocked(t *testing.T) {
+	e := NewStdEncoding().Option(Blocklist(DefaultBlocklist...))
+
+	assert.True(t, e.blocked("xxSHITxx"))
+	assert.True(t, e.blocked("sh1t"))
+	assert.True(t, e.blocked("Fuck"))
+	assert.False(t, e.blocked("5Frvgk"))
+
+	// Nothing is blocked by default
+	assert.False(t, StdEncoding.blocked("shit"))
+}
+
+func TestBlocklistRandomString(t *testing.T) {
+	e := NewStdEncoding().Option(Blocklist("abc"))
+
+	// The first string drawn is blocked, so another is drawn
+	r := bytes.NewReader([]byte{36, 37, 38, 0, 1, 2, 3, 4})
+	s, err := e.randomString(r, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "123", s)
+
+	// Giving up rather than drawing forever when every string is blocked
+	_, err = NewStdEncoding().Option(Blocklist("a")).randomString(bytes.NewReader(bytes.Repeat([]byte{36}, 1000)), 1)
+	assert.IsType(t, ErrInvalidConfig{}, err)
+}
+
+func TestBlocklistMonotonicTimeID(t *testing.T) {
+	m := NewStdEncoding().Option(Blocklist("ab")).NewMonotonic(bytes.NewReader([]byte{36, 36, 61, 0}))
+	now := time.UnixMilli(1700000000123)
+
+	s, err := m.TimeID(now, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "0Tvcokihaaz", s)
+
+	// Incrementing to ab0 is blocked, so every suffix starting ab is skipped
+	s, err = m.TimeID(now, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "0Tvcokihac0", s)
+}
+
+func TestBlocklistCounter(t *testing.T) {
+	e := NewStdEncoding().Option(Blocklist("cum"))
+
+	// cum is 149592, which is skipped
+	c := e.NewCounter(149590)
+	n, s := c.Next()
+	assert.Equal(t, int64(149591), n)
+	assert.Equal(t, "cul", s)
+
+	n, s = c.Next()
+	assert.Equal(t, int64(149593), n)
+	assert.Equal(t, "cun", s)
+}
+
+func TestBlocklistString(t *testing.T) {
+	e := NewStdEncoding().Option(Blocklist("Foo", "b4r"))
+
+	assert.Equal(t,
+		`base62.NewEncoding("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz").Option(base62.Blocklist("foo", "bar"))`,
+		fmt.Sprintf("%#v", e))
+	assert.Contains(t, e.String(), "blocklist: 2 words")
+}
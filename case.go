@@ -0,0 +1,30 @@
+package base62
+
+// CaseInsensitive sets letters to be decoded regardless of case, for codes
+// typed by people who don't preserve it. Only letters whose other case is
+// not also in the alphabet are folded, so that decoding is unambiguous,
+// which means this has no effect for alphabets such as the standard one
+// that use both cases of every letter
+func CaseInsensitive() option {
+	return func(e *Encoding) {
+		e.caseInsensitive = true
+
+		for i := 0; i < len(e.encode); i++ {
+			if o := otherCase(e.encode[i]); o != 0 && e.decode[o] == invalidDigit {
+				e.decode[o] = byte(i)
+			}
+		}
+	}
+}
+
+// otherCase returns the opposite case of an ASCII letter, or zero if c is
+// not a letter
+func otherCase(c byte) byte {
+	switch {
+	case 'a' <= c && c <= 'z':
+		return c - 'a' + 'A'
+	case 'A' <= c && c <= 'Z':
+		return c - 'A' + 'a'
+	}
+	return 0
+}
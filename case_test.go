@@ -0,0 +1,47 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// upperAlphabet uses only uppercase letters, with symbols for the remainder
+const upperAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ!#$%&()*+,./:;<=>?@[]^_{|}"
+
+func TestCaseInsensitive(t *testing.T) {
+	e := NewEncoding(upperAlphabet).Option(CaseInsensitive())
+
+	v := e.EncodeInt64(4815162342)
+	assert.Equal(t, "5F?^).", v)
+
+	for _, s := range []string{"5F?^).", "5f?^)."} {
+		n, err := e.DecodeToInt64(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, int64(4815162342), n, s)
+	}
+
+	upper, err := e.DecodeToInt64("BASE62")
+	require.NoError(t, err)
+	lower, err := e.DecodeToInt64("base62")
+	require.NoError(t, err)
+	assert.Equal(t, upper, lower)
+
+	// Case is still significant without the option
+	_, err = NewEncoding(upperAlphabet).DecodeToInt64("5f?^).")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestCaseInsensitiveAmbiguous(t *testing.T) {
+	// Both cases of every letter are digits of the standard alphabet
+	e := NewStdEncoding().Option(CaseInsensitive())
+
+	n, err := e.DecodeToInt64("a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(36), n)
+
+	n, err = e.DecodeToInt64("A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), n)
+}
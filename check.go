@@ -0,0 +1,132 @@
+package base62
+
+import (
+	"fmt"
+)
+
+// EncodeInt64Check returns the base62 encoding of n followed by a check
+// character using the StdEncoding, see Encoding.EncodeInt64Check
+func EncodeInt64Check(n int64) string {
+	return StdEncoding.EncodeInt64Check(n)
+}
+
+// DecodeInt64Check decodes a base62 encoded string ending in a check
+// character using the StdEncoding, see Encoding.DecodeInt64Check
+func DecodeInt64Check(s string) (int64, error) {
+	return StdEncoding.DecodeInt64Check(s)
+}
+
+// EncodeInt64Check returns the encoding of n as with EncodeInt64, followed
+// by a single check character so that typing errors in codes entered by
+// people can be detected cheaply. The check character is the sum of the
+// digits weighted alternately by 3 and 1 from the right, mod 62, so every
+// single character substitution is detected, as is the transposition of
+// adjacent digits unless their values differ by exactly 31
+func (e *Encoding) EncodeInt64Check(n int64) string {
+	s := e.EncodeInt64(n)
+	return s + string(e.encode[e.checksum(s)])
+}
+
+// DecodeInt64Check decodes a string produced by EncodeInt64Check, returning
+// an ErrChecksum if the check character doesn't match
+func (e *Encoding) DecodeInt64Check(s string) (int64, error) {
+	s = e.unformat(s)
+	if len(s) < 2 {
+		return 0, ErrInvalidLength{fmt.Errorf("Encoded length %d is too short to include a check character", len(s))}
+	}
+
+	v, c := s[:len(s)-1], e.index(s[len(s)-1])
+	if c == -1 {
+		return 0, e.invalidCharacter(s, len(s)-1)
+	}
+
+	n, err := e.DecodeToInt64(v)
+	if err != nil {
+		return 0, err
+	}
+	if want := e.checksum(v); c != want {
+		return 0, ErrChecksum{fmt.Errorf("Check character %c of %s does not match, expected %c", s[len(s)-1], v, e.encode[want])}
+	}
+
+	return n, nil
+}
+
+// checksum returns the weighted sum of the digits of s mod 62, skipping
+// characters such as separators and sign markers which aren't digits
+func (e *Encoding) checksum(s string) int {
+	var (
+		sum    int
+		weight = 3
+	)
+	for i := len(s) - 1; i >= 0; i-- {
+		d := e.index(s[i])
+		if d == -1 {
+			continue
+		}
+		sum += d * weight
+		weight = 4 - weight
+	}
+
+	return sum % base
+}
+
+// Luhn sets integers to be encoded with a trailing check character computed
+// by the Luhn mod N algorithm over the alphabet, which is verified and
+// removed when decoding, returning an ErrChecksum if it doesn't match. This
+// detects every single character error and most adjacent transpositions in
+// codes entered by people, and is compatible with Luhn mod 62
+// implementations elsewhere using the same alphabet. Characters which
+// aren't digits, such as a sign marker, are skipped. The check character
+// directly follows the digits, counting towards the Padding width, with any
+// padding or separators added around them
+func Luhn() option {
+	return func(e *Encoding) {
+		e.luhn = true
+	}
+}
+
+// luhnAppend appends the Luhn check character to the digits of s, if
+// enabled, before any padding. An empty zero is left without one
+func (e *Encoding) luhnAppend(s string) string {
+	if !e.luhn || s == "" {
+		return s
+	}
+
+	return s + string(e.encode[(base-e.luhnSum(s, 2))%base])
+}
+
+// luhnStrip verifies and removes the Luhn check character of s, if enabled
+func (e *Encoding) luhnStrip(s string) (string, error) {
+	if !e.luhn || s == "" && e.emptyZero {
+		return s, nil
+	}
+	if len(s) < 2 {
+		return "", ErrInvalidLength{fmt.Errorf("Encoded length %d is too short to include a check character", len(s))}
+	}
+	if e.index(s[len(s)-1]) == -1 {
+		return "", e.invalidCharacter(s, len(s)-1)
+	}
+	if e.luhnSum(s, 1) != 0 {
+		return "", ErrChecksum{fmt.Errorf("Luhn check character of %s does not match", s)}
+	}
+
+	return s[:len(s)-1], nil
+}
+
+// luhnSum returns the Luhn sum of the digits of s mod 62, doubling
+// alternate digits from the right starting with the given factor
+func (e *Encoding) luhnSum(s string, factor int) int {
+	var sum int
+	for i := len(s) - 1; i >= 0; i-- {
+		d := e.index(s[i])
+		if d == -1 {
+			continue
+		}
+
+		addend := d * factor
+		sum += addend/base + addend%base
+		factor = 3 - factor
+	}
+
+	return sum % base
+}
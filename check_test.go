@@ -0,0 +1,164 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeInt64Check(t *testing.T) {
+	testcases := []struct {
+		num     int64
+		encoded string
+	}{
+		{0, "00"},
+		{1, "13"},
+		{4815162342, "5FrvgkK"},
+		{9223372036854775807, "AzL8n0Y58m7D"},
+	}
+
+	for _, tc := range testcases {
+		v := EncodeInt64Check(tc.num)
+		t.Logf("Encoded %d as %s", tc.num, v)
+		assert.Equal(t, tc.encoded, v)
+
+		n, err := DecodeInt64Check(v)
+		require.NoError(t, err)
+		assert.Equal(t, tc.num, n)
+	}
+}
+
+func TestDecodeInt64CheckErrors(t *testing.T) {
+	// Every single character substitution is detected
+	s := "5FrvgkK"
+	for i := 0; i < len(s); i++ {
+		for _, c := range []byte(encodeStd) {
+			if c == s[i] {
+				continue
+			}
+			typo := s[:i] + string(c) + s[i+1:]
+			_, err := DecodeInt64Check(typo)
+			assert.IsType(t, ErrChecksum{}, err, typo)
+		}
+	}
+
+	// As are adjacent transpositions
+	for _, typo := range []string{"F5rvgkK", "5rFvgkK", "5FvrgkK", "5FrgvkK", "5FrvkgK", "5FrvgKk"} {
+		_, err := DecodeInt64Check(typo)
+		assert.IsType(t, ErrChecksum{}, err, typo)
+	}
+
+	_, err := DecodeInt64Check("5")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeInt64Check("5Frvgk-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestEncodeInt64CheckFormatted(t *testing.T) {
+	e := NewStdEncoding().Option(Sign('-'), Group(3, " "))
+
+	v := e.EncodeInt64Check(-4815162342)
+	assert.Equal(t, "-5F rvg kK", v)
+
+	n, err := e.DecodeInt64Check(v)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-4815162342), n)
+}
+
+func TestLuhn(t *testing.T) {
+	e := NewStdEncoding().Option(Luhn())
+
+	testcases := []struct {
+		num     int64
+		encoded string
+	}{
+		{0, "00"},
+		{1, "1y"},
+		{4815162342, "5FrvgkY"},
+		{9223372036854775807, "AzL8n0Y58m7q"},
+	}
+
+	for _, tc := range testcases {
+		v := e.EncodeInt64(tc.num)
+		t.Logf("Encoded %d as %s", tc.num, v)
+		assert.Equal(t, tc.encoded, v)
+		assert.Equal(t, tc.encoded, string(e.AppendInt64(nil, tc.num)))
+		assert.Equal(t, tc.encoded, e.EncodeUint64(uint64(tc.num)))
+
+		n, err := e.DecodeToInt64(v)
+		require.NoError(t, err)
+		assert.Equal(t, tc.num, n)
+
+		u, err := e.DecodeToUint64(v)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(tc.num), u)
+	}
+}
+
+func TestLuhnErrors(t *testing.T) {
+	e := NewStdEncoding().Option(Luhn())
+
+	// Every single character substitution is detected
+	s := "5FrvgkY"
+	for i := 0; i < len(s); i++ {
+		for _, c := range []byte(encodeStd) {
+			if c == s[i] {
+				continue
+			}
+			typo := s[:i] + string(c) + s[i+1:]
+			_, err := e.DecodeToInt64(typo)
+			assert.IsType(t, ErrChecksum{}, err, typo)
+		}
+	}
+
+	_, ok := e.TryDecodeToInt64("5FrvgYk")
+	assert.False(t, ok)
+
+	_, err := e.DecodeToInt64("5")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	// The check character applies with padding and signs
+	signed := NewStdEncoding().Option(Luhn(), Sign('-'), Padding(4))
+	v := signed.EncodeInt64(-72)
+	assert.Equal(t, "-1Af", v)
+	n, err := signed.DecodeToInt64(v)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-72), n)
+}
+
+func TestLuhnFormatting(t *testing.T) {
+	testcases := []struct {
+		e       *Encoding
+		num     int64
+		encoded string
+	}{
+		{NewStdEncoding().Option(Luhn(), PaddingChar('_'), Align(AlignLeft), Padding(7)), 1, "1y_____"},
+		{NewStdEncoding().Option(Luhn(), PaddingChar('_'), Padding(7)), 4815162342, "5FrvgkY"},
+		{NewStdEncoding().Option(Luhn(), PaddingChar('_'), Padding(8)), 0, "______00"},
+		{NewStdEncoding().Option(Luhn(), Padding(7)), 1, "000001y"},
+		{NewStdEncoding().Option(Luhn(), EmptyZero()), 0, ""},
+		{NewStdEncoding().Option(Luhn(), EmptyZero()), 1, "1y"},
+		{NewStdEncoding().Option(Luhn(), EmptyZero(), Padding(4)), 0, "0000"},
+		{NewStdEncoding().Option(Luhn(), Group(4, "-")), 4815162342, "5Frv-gkY"},
+		{NewStdEncoding().Option(Luhn(), Sign('~'), PaddingChar('_'), Align(AlignLeft), Padding(6)), -72, "~1Af__"},
+	}
+
+	for _, tc := range testcases {
+		v := tc.e.EncodeInt64(tc.num)
+		t.Logf("Encoded %d as %q with %v", tc.num, v, tc.e)
+		assert.Equal(t, tc.encoded, v)
+
+		n, err := tc.e.DecodeToInt64(v)
+		require.NoError(t, err)
+		assert.Equal(t, tc.num, n)
+
+		if tc.num >= 0 {
+			assert.Equal(t, tc.encoded, tc.e.EncodeUint64(uint64(tc.num)))
+			u, err := tc.e.DecodeToUint64(v)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(tc.num), u)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Command base62 encodes and decodes base62 values from the command line.
+//
+// Usage:
+//
+//	base62 [-encoding spec] encode VALUE...|-
+//	base62 [-encoding spec] decode VALUE...|-
+//	base62 [-encoding spec] [-workers n] encode-file IN OUT
+//	base62 [-encoding spec] [-workers n] decode-file IN OUT
+//	base62 [-encoding spec] vectors
+//
+// The encode and decode commands convert decimal int64 values to base62 and
+// back. Given "-" they instead act as a filter, reading values from stdin one
+// per line and writing each result to stdout as it is read, so they compose
+// with awk, sort and xargs. The file commands convert binary data across all
+// cores with EncodeParallel and DecodeParallel, where "-" names stdin or
+// stdout. The vectors command writes the canonical test vectors as JSON, for
+// reimplementations in other languages to verify against
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/mattheath/base62"
+)
+
+// errUsage reports invalid arguments, after printing the usage
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	switch {
+	case errors.Is(err, errUsage):
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintln(os.Stderr, "base62:", err)
+		os.Exit(1)
+	}
+}
+
+// run executes the command line args, returning errUsage for invalid usage
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("base62", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprint(stderr, `usage:
+  base62 [flags] encode VALUE...|-
+  base62 [flags] decode VALUE...|-
+  base62 [flags] encode-file IN OUT
+  base62 [flags] decode-file IN OUT
+  base62 [flags] vectors
+
+flags:
+`)
+		fs.PrintDefaults()
+	}
+
+	spec := fs.String("encoding", "std", "`spec` of the encoding, as parsed by base62.ParseSpec")
+	workers := fs.Int("workers", 0, "goroutines used by the file commands, or zero for GOMAXPROCS")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+
+	e, err := base62.ParseSpec(*spec)
+	if err != nil {
+		return err
+	}
+
+	args = fs.Args()
+	if len(args) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	cmd, args := args[0], args[1:]
+	switch {
+	case (cmd == "encode" || cmd == "decode") && len(args) > 0:
+		return convert(e, cmd == "decode", args, stdin, stdout)
+	case (cmd == "encode-file" || cmd == "decode-file") && len(args) == 2:
+		return convertFile(e, cmd == "decode-file", args[0], args[1], *workers, stdin, stdout)
+	case cmd == "vectors" && len(args) == 0:
+		return e.WriteTestVectors(stdout)
+	}
+
+	fs.Usage()
+	return errUsage
+}
+
+// convert encodes or decodes each of the values, or the lines of stdin
+func convert(e *base62.Encoding, decode bool, values []string, stdin io.Reader, stdout io.Writer) error {
+	if len(values) == 1 && values[0] == "-" {
+		return e.TransformLines(stdout, stdin, base62.LineTransform{Decode: decode})
+	}
+
+	for _, v := range values {
+		if decode {
+			n, err := e.DecodeToInt64(v)
+			if err != nil {
+				return err
+			}
+			v = strconv.FormatInt(n, 10)
+		} else {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return err
+			}
+			v = e.EncodeInt64(n)
+		}
+
+		if _, err := fmt.Fprintln(stdout, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// convertFile encodes or decodes the binary data of the file in to the file
+// out across multiple goroutines
+func convertFile(e *base62.Encoding, decode bool, in, out string, workers int, stdin io.Reader, stdout io.Writer) error {
+	var (
+		src []byte
+		err error
+	)
+	if in == "-" {
+		src, err = io.ReadAll(stdin)
+	} else {
+		src, err = os.ReadFile(in)
+	}
+	if err != nil {
+		return err
+	}
+
+	var dst []byte
+	if decode {
+		// Allow for a trailing newline, as added by editors and echo
+		dst, err = e.DecodeParallel(bytes.TrimRight(src, "\r\n"), workers)
+		if err != nil {
+			return err
+		}
+	} else {
+		dst = e.EncodeParallel(src, workers)
+	}
+
+	if out == "-" {
+		_, err = stdout.Write(dst)
+		return err
+	}
+	return os.WriteFile(out, dst, 0o644)
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mattheath/base62"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunValues(t *testing.T) {
+	testCases := []struct {
+		args []string
+		out  string
+	}{
+		{[]string{"encode", "4815162342", "0"}, "5Frvgk\n0\n"},
+		{[]string{"decode", "5Frvgk", "0"}, "4815162342\n0\n"},
+		{[]string{"-encoding", "std;pad=8", "encode", "4815162342"}, "005Frvgk\n"},
+	}
+
+	for _, tc := range testCases {
+		var out bytes.Buffer
+		err := run(tc.args, nil, &out, &out)
+		require.NoError(t, err)
+		t.Logf("Ran %v with output %q", tc.args, out.String())
+		assert.Equal(t, tc.out, out.String())
+	}
+}
+
+func TestRunPipe(t *testing.T) {
+	var out bytes.Buffer
+	err := run([]string{"encode", "-"}, strings.NewReader("4815162342\n1\n"), &out, &out)
+	require.NoError(t, err)
+	assert.Equal(t, "5Frvgk\n1\n", out.String())
+
+	var decoded bytes.Buffer
+	err = run([]string{"decode", "-"}, &out, &decoded, &decoded)
+	require.NoError(t, err)
+	assert.Equal(t, "4815162342\n1\n", decoded.String())
+
+	// The first invalid line stops the filter
+	err = run([]string{"decode", "-"}, strings.NewReader("5Frvgk\n!\n"), &out, &out)
+	assert.ErrorContains(t, err, "Line 2")
+}
+
+func TestRunFile(t *testing.T) {
+	var (
+		dir     = t.TempDir()
+		in      = filepath.Join(dir, "in")
+		encoded = filepath.Join(dir, "encoded")
+		out     = filepath.Join(dir, "out")
+	)
+
+	b := make([]byte, 10000)
+	for i := range b {
+		b[i] = byte(i * 37)
+	}
+	require.NoError(t, os.WriteFile(in, b, 0o644))
+
+	var stdout bytes.Buffer
+	require.NoError(t, run([]string{"-workers", "4", "encode-file", in, encoded}, nil, &stdout, &stdout))
+
+	v, err := os.ReadFile(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, base62.EncodeBytes(b), string(v))
+
+	// Trailing newlines are ignored when decoding
+	require.NoError(t, os.WriteFile(encoded, append(v, '\n'), 0o644))
+	require.NoError(t, run([]string{"decode-file", encoded, out}, nil, &stdout, &stdout))
+
+	decoded, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(b, decoded))
+
+	// Stdin and stdout are named by "-"
+	stdout.Reset()
+	require.NoError(t, run([]string{"encode-file", "-", "-"}, bytes.NewReader(b), &stdout, &stdout))
+	assert.Equal(t, base62.EncodeBytes(b), stdout.String())
+}
+
+func TestRunVectors(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, run([]string{"vectors"}, nil, &out, &out))
+
+	var v base62.TestVectors
+	require.NoError(t, json.Unmarshal(out.Bytes(), &v))
+
+	var want bytes.Buffer
+	require.NoError(t, base62.WriteTestVectors(&want))
+	assert.Equal(t, want.String(), out.String())
+}
+
+func TestRunInvalid(t *testing.T) {
+	for _, args := range [][]string{
+		nil,
+		{"encode"},
+		{"unknown", "1"},
+		{"encode-file", "in"},
+		{"vectors", "extra"},
+		{"-unknown", "encode", "1"},
+	} {
+		var out bytes.Buffer
+		err := run(args, nil, &out, &out)
+		assert.ErrorIs(t, err, errUsage)
+		assert.Contains(t, out.String(), "usage")
+	}
+
+	var out bytes.Buffer
+	err := run([]string{"decode", "!"}, nil, &out, &out)
+	assert.IsType(t, base62.ErrInvalidCharacter{}, err)
+
+	err = run([]string{"-encoding", "unknown", "encode", "1"}, nil, &out, &out)
+	assert.IsType(t, base62.ErrInvalidConfig{}, err)
+}
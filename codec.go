@@ -0,0 +1,169 @@
+package base62
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/mattheath/base62/core"
+)
+
+// Codec is the interface of a numeral system encoding integers and bytes
+// as strings, implemented by Encoding. Byte arrays, including ULIDs and
+// KSUIDs, URL paths and line transforms accept a Codec through the With
+// functions, so alternative numeral systems or accelerated implementations
+// may be plugged in. Counters, Hashers, Int62, snowflakes and columns work
+// on the alphabet or unsigned values of an Encoding, so require one
+type Codec interface {
+	EncodeInt64(n int64) string
+	DecodeToInt64(s string) (int64, error)
+	EncodeBytes(b []byte) string
+	DecodeBytes(s string) ([]byte, error)
+}
+
+var _ Codec = (*Encoding)(nil)
+
+// BlockSize is the number of bytes in each block of encoded binary data
+const BlockSize = 32
+
+// blockWidth is the encoded width of a full block
+var blockWidth = core.EncodedLen(BlockSize)
+
+// EncodeBytes returns the base62 encoding of b using the StdEncoding,
+// see Encoding.EncodeBytes
+func EncodeBytes(b []byte) string {
+	return StdEncoding.EncodeBytes(b)
+}
+
+// DecodeBytes decodes a base62 encoded byte slice using the StdEncoding,
+// see Encoding.DecodeBytes
+func DecodeBytes(s string) ([]byte, error) {
+	return StdEncoding.DecodeBytes(s)
+}
+
+// EncodeToString returns the base62 encoding of src using the StdEncoding,
+// see Encoding.EncodeToString
+func EncodeToString(src []byte) string {
+	return StdEncoding.EncodeToString(src)
+}
+
+// DecodeString decodes a base62 encoded string using the StdEncoding,
+// see Encoding.DecodeString
+func DecodeString(s string) ([]byte, error) {
+	return StdEncoding.DecodeString(s)
+}
+
+// EncodeBytes returns the base62 encoding of b. The bytes are split into
+// blocks of BlockSize bytes, each interpreted as an unsigned integer in the
+// ByteOrder of the encoding and encoded to the fixed width for its number
+// of bytes, so leading zero bytes are preserved, the length of b is
+// recovered when decoding, and encoding takes linear time. This is the
+// format of EncodeToString, Encode, EncodeParallel and NewEncoder, and any
+// of their decoders may decode it
+func (e *Encoding) EncodeBytes(b []byte) string {
+	return string(e.Encode(b))
+}
+
+// DecodeBytes decodes a base62 encoded byte slice, with the number of bytes
+// determined by the length of the encoding. It decodes the output of any of
+// EncodeBytes, EncodeToString, Encode, EncodeParallel and NewEncoder, which
+// share one format, and rejects lengths the format can't produce
+func (e *Encoding) DecodeBytes(s string) ([]byte, error) {
+	if err := e.checkLen(s); err != nil {
+		return nil, err
+	}
+
+	n, ok := bytesLen(len(s))
+	if !ok {
+		return nil, ErrInvalidLength{fmt.Errorf("Encoded length %d is not the width of any number of bytes", len(s))}
+	}
+	if i := e.invalidAt(s, 0); i != -1 {
+		return nil, e.invalidCharacter(s, i)
+	}
+
+	b := make([]byte, n)
+	if err := e.decodeBlocks(b, s); err != nil {
+		return nil, err
+	}
+
+	return e.transforms.decode(b)
+}
+
+// Encode returns the base62 encoding of src, as with EncodeBytes
+func (e *Encoding) Encode(src []byte) []byte {
+	src = e.transforms.encode(src)
+
+	out := make([]byte, EncodedLen(len(src)))
+	e.putBlocks(out, src)
+	return out
+}
+
+// Decode decodes base62 encoded src, as with DecodeBytes
+func (e *Encoding) Decode(src []byte) ([]byte, error) {
+	return e.DecodeBytes(string(src))
+}
+
+// EncodeToString returns the base62 encoding of src, as with EncodeBytes,
+// mirroring encoding/base64
+func (e *Encoding) EncodeToString(src []byte) string {
+	return e.EncodeBytes(src)
+}
+
+// DecodeString decodes a base62 encoded string, as with DecodeBytes,
+// mirroring encoding/base64. It pairs with EncodeToString, and equally
+// decodes the output of EncodeParallel and NewEncoder
+func (e *Encoding) DecodeString(s string) ([]byte, error) {
+	return e.DecodeBytes(s)
+}
+
+// EncodedLen returns the length of the encoding of n bytes by EncodeBytes,
+// so buffers and database columns can be sized in advance
+func EncodedLen(n int) int {
+	return n/BlockSize*blockWidth + core.EncodedLen(n%BlockSize)
+}
+
+// DecodedLen returns the maximum number of bytes decoded from an encoding
+// of n characters by DecodeBytes
+func DecodedLen(n int) int {
+	return n/blockWidth*BlockSize + int(float64(n%blockWidth)*math.Log2(base)/8)
+}
+
+// bytesLen returns the number of bytes with an encoding of w characters,
+// the inverse of EncodedLen. Each byte needs more than one character so at
+// most one number of bytes has a given width
+func bytesLen(w int) (int, bool) {
+	n := DecodedLen(w)
+	if EncodedLen(n) != w {
+		return 0, false
+	}
+
+	return n, true
+}
+
+// block returns block i of b, split into blocks of size elements, the last
+// of which may be partial or empty
+func block[S ~[]byte | ~string](b S, i, size int) S {
+	return b[i*size : min((i+1)*size, len(b))]
+}
+
+// putBlocks writes the encoding of src to out, which must be exactly
+// EncodedLen(len(src)) long, encoding each block to its fixed width
+func (e *Encoding) putBlocks(out, src []byte) {
+	for i := 0; i <= len(src)/BlockSize; i++ {
+		e.putFixed(block(out, i, blockWidth), block(src, i, BlockSize))
+	}
+}
+
+// decodeBlocks decodes s into b, which must be the same length as the
+// bytes originally encoded
+func (e *Encoding) decodeBlocks(b []byte, s string) error {
+	if w := EncodedLen(len(b)); len(s) != w {
+		return ErrInvalidLength{fmt.Errorf("Encoded %d bytes must be %d characters, got %d", len(b), w, len(s))}
+	}
+
+	for i := 0; i <= len(b)/BlockSize; i++ {
+		if err := e.decodeFixed(block(s, i, blockWidth), block(b, i, BlockSize)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package base62
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mattheath/base62/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeBytes(t *testing.T) {
+	testCases := []struct {
+		b       []byte
+		encoded string
+	}{
+		{[]byte{}, ""},
+		{[]byte{0}, "00"},
+		{[]byte{0xff}, "47"},
+		{[]byte{0, 0, 0, 1}, "000001"},
+		{[]byte{0xde, 0xad, 0xbe, 0xef}, "44pZgF"},
+	}
+
+	for _, tc := range testCases {
+		s := EncodeBytes(tc.b)
+		t.Logf("Encoded %x as %s", tc.b, s)
+		assert.Equal(t, tc.encoded, s)
+
+		b, err := DecodeBytes(s)
+		require.NoError(t, err)
+		assert.Equal(t, tc.b, b)
+	}
+}
+
+func TestEncodeBytesLengths(t *testing.T) {
+	for n := 0; n <= 128; n++ {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = byte(i * 37)
+		}
+
+		v, err := DecodeBytes(EncodeBytes(b))
+		require.NoError(t, err)
+		assert.Equal(t, b, v)
+
+		l, ok := bytesLen(core.EncodedLen(n))
+		assert.True(t, ok)
+		assert.Equal(t, n, l)
+	}
+}
+
+func TestEncodeBytesBlocks(t *testing.T) {
+	b := make([]byte, BlockSize*2+16)
+	for i := range b {
+		b[i] = byte(i * 37)
+	}
+
+	// Each block is the fixed width encoding of its bytes
+	s := EncodeBytes(b)
+	assert.Len(t, s, 2*43+22)
+	assert.Equal(t, EncodeArray([32]byte(b[:32])), s[:43])
+	assert.Equal(t, EncodeArray([32]byte(b[32:64])), s[43:86])
+	assert.Equal(t, EncodeArray([16]byte(b[64:])), s[86:])
+
+	// Encoding is linear, so large inputs are practical
+	b = make([]byte, 1<<20)
+	for i := range b {
+		b[i] = byte(i * 37)
+	}
+	v, err := DecodeBytes(EncodeBytes(b))
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(b, v))
+}
+
+func TestDecodeBytesInvalid(t *testing.T) {
+	_, err := DecodeBytes("0")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeBytes("zz")
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = DecodeBytes("0-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+// hexCodec is an alternative Codec using hexadecimal
+type hexCodec struct{}
+
+func (hexCodec) EncodeInt64(n int64) string { return strconv.FormatInt(n, 16) }
+
+func (hexCodec) DecodeToInt64(s string) (int64, error) { return strconv.ParseInt(s, 16, 64) }
+
+func (hexCodec) EncodeBytes(b []byte) string { return hex.EncodeToString(b) }
+
+func (hexCodec) DecodeBytes(s string) ([]byte, error) { return hex.DecodeString(s) }
+
+func TestCodec(t *testing.T) {
+	a := [4]byte{0xde, 0xad, 0xbe, 0xef}
+
+	s := EncodeArrayWith(hexCodec{}, a)
+	assert.Equal(t, "deadbeef", s)
+
+	v, err := DecodeArrayWith[[4]byte](hexCodec{}, s)
+	require.NoError(t, err)
+	assert.Equal(t, a, v)
+
+	_, err = DecodeArrayWith[[4]byte](hexCodec{}, "dead")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	u := ULID{15: 1}
+	s = EncodeArrayWith(hexCodec{}, u)
+	assert.Equal(t, "00000000000000000000000000000001", s)
+	assert.Equal(t, EncodeULID(u), EncodeArrayWith(StdEncoding, u))
+
+	p, err := EncodePathWith(hexCodec{}, "/users/{user}", 255)
+	require.NoError(t, err)
+	assert.Equal(t, "/users/ff", p)
+
+	ids, err := DecodePathWith(hexCodec{}, "/users/{user}", p)
+	require.NoError(t, err)
+	assert.Equal(t, int64(255), ids["user"])
+
+	var out strings.Builder
+	err = TransformLinesWith(hexCodec{}, &out, strings.NewReader("255\n4096\n"), LineTransform{})
+	require.NoError(t, err)
+	assert.Equal(t, "ff\n1000\n", out.String())
+}
+
+func TestEncode(t *testing.T) {
+	for _, b := range [][]byte{{}, {0}, {0, 0, 0, 1}, {0xde, 0xad, 0xbe, 0xef}} {
+		s := StdEncoding.Encode(b)
+		assert.Equal(t, EncodeBytes(b), string(s))
+
+		v, err := StdEncoding.Decode(s)
+		require.NoError(t, err)
+		assert.Equal(t, b, v)
+	}
+
+	_, err := StdEncoding.Decode([]byte("0"))
+	assert.IsType(t, ErrInvalidLength{}, err)
+}
+
+func TestEncodeToString(t *testing.T) {
+	b := []byte("hello, world")
+
+	s := EncodeToString(b)
+	assert.Equal(t, EncodeBytes(b), s)
+
+	v, err := DecodeString(s)
+	require.NoError(t, err)
+	assert.Equal(t, b, v)
+
+	_, err = DecodeString("0-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestDecodeStringFormats(t *testing.T) {
+	for _, size := range []int{0, 1, 32, 40, 64, 1000} {
+		src := make([]byte, size)
+		for i := range src {
+			src[i] = byte(i*37 + 1)
+		}
+
+		var buf bytes.Buffer
+		enc := NewEncoder(StdEncoding, &buf)
+		_, err := enc.Write(src)
+		require.NoError(t, err)
+		require.NoError(t, enc.Close())
+
+		// Every encoder produces the same output, which decodes with each
+		// of the decoders
+		s := EncodeToString(src)
+		assert.Equal(t, s, buf.String(), "size %d", size)
+		assert.Equal(t, s, string(EncodeParallel(src, 2)), "size %d", size)
+
+		v, err := DecodeString(buf.String())
+		require.NoError(t, err)
+		assert.Equal(t, src, v, "size %d", size)
+
+		v, err = io.ReadAll(NewDecoder(StdEncoding, strings.NewReader(s)))
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(src, v), "size %d", size)
+	}
+
+	// No number of bytes has a final block one character wide
+	_, err := DecodeString(strings.Repeat("0", blockWidth+1))
+	assert.IsType(t, ErrInvalidLength{}, err)
+}
+
+func TestEncodedLen(t *testing.T) {
+	testcases := []struct {
+		bytes, chars int
+	}{
+		{0, 0},
+		{1, 2},
+		{4, 6},
+		{8, 11},
+		{16, 22},
+		{32, 43},
+	}
+
+	for _, tc := range testcases {
+		assert.Equal(t, tc.chars, EncodedLen(tc.bytes))
+		assert.Equal(t, tc.bytes, DecodedLen(tc.chars))
+	}
+
+	for n := 0; n <= 256; n++ {
+		b := make([]byte, n)
+		assert.Equal(t, len(EncodeBytes(b)), EncodedLen(n))
+	}
+
+	for n := 0; n <= 1<<16; n++ {
+		if DecodedLen(EncodedLen(n)) != n {
+			t.Fatalf("Decoded length of %d bytes is %d", n, DecodedLen(EncodedLen(n)))
+		}
+
+		// Any fewer characters cannot hold n bytes
+		if n > 0 && DecodedLen(EncodedLen(n)-1) >= n {
+			t.Fatalf("%d characters hold %d bytes", EncodedLen(n)-1, n)
+		}
+	}
+
+	assert.Equal(t, MaxLenInt64, len(EncodeUint64(math.MaxUint64)))
+}
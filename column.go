@@ -0,0 +1,126 @@
+package base62
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// EncodeUint64Column encodes a column of values using the StdEncoding,
+// see Encoding.EncodeUint64Column
+func EncodeUint64Column(vals []uint64) ([]byte, []int32, error) {
+	return StdEncoding.EncodeUint64Column(vals)
+}
+
+// DecodeUint64Column decodes a column of values using the StdEncoding,
+// see Encoding.DecodeUint64Column
+func DecodeUint64Column(data []byte, offsets []int32) ([]uint64, error) {
+	return StdEncoding.DecodeUint64Column(data, offsets)
+}
+
+// EncodeUint64Column encodes an entire column of values in a single pass
+// into an Arrow style string column layout: one contiguous buffer of the
+// encoded values, and len(vals)+1 offsets where value i is held in
+// data[offsets[i]:offsets[i+1]]. No allocations are made per value, so ID
+// columns can be exported to Arrow or Parquet cheaply. Values are encoded
+// and padded as with EncodeUint64. The offsets are int32, as in the Arrow
+// string type, so an ErrOverflow is returned for columns whose encoding
+// exceeds math.MaxInt32 bytes
+func (e *Encoding) EncodeUint64Column(vals []uint64) ([]byte, []int32, error) {
+	var (
+		data    = make([]byte, 0, len(vals)*max(e.padding, MaxLenInt64))
+		offsets = make([]int32, 1, len(vals)+1)
+	)
+
+	for _, n := range vals {
+		start := len(data)
+		if !e.inStep() {
+			data = append(data, e.EncodeUint64(n)...)
+			end, err := columnOffset(len(data))
+			if err != nil {
+				return nil, nil, err
+			}
+			offsets = append(offsets, end)
+			continue
+		}
+
+		// Append digits least significant first, then reverse them in place
+		for n > 0 {
+			data = append(data, e.encode[n%base])
+			n /= base
+		}
+		if len(data) == start && !e.emptyZero {
+			data = append(data, e.encode[0])
+		}
+		for len(data)-start < e.padding && e.padChar == 0 {
+			data = append(data, e.encode[0])
+		}
+		slices.Reverse(data[start:])
+		if l := len(data) - start; l < e.padding && e.padChar != 0 {
+			data = append(data[:start], e.padValue(string(data[start:]), e.padding)...)
+		}
+
+		end, err := columnOffset(len(data))
+		if err != nil {
+			return nil, nil, err
+		}
+		offsets = append(offsets, end)
+	}
+
+	return data, offsets, nil
+}
+
+// columnOffset returns the int32 offset of the end of a column of n bytes
+func columnOffset(n int) (int32, error) {
+	if n > math.MaxInt32 {
+		return 0, ErrOverflow{fmt.Errorf("Column of %d bytes exceeds int32 offsets", n)}
+	}
+	return int32(n), nil
+}
+
+// DecodeUint64Column decodes a column in the layout produced by
+// EncodeUint64Column
+func (e *Encoding) DecodeUint64Column(data []byte, offsets []int32) ([]uint64, error) {
+	if len(offsets) == 0 {
+		return nil, ErrInvalidLength{fmt.Errorf("Column offsets must include the start offset")}
+	}
+
+	vals := make([]uint64, len(offsets)-1)
+	for i := range vals {
+		start, end := offsets[i], offsets[i+1]
+		if start < 0 || end < start || int(end) > len(data) {
+			return nil, ErrInvalidLength{fmt.Errorf("Column offsets %d to %d of value %d are out of range", start, end, i)}
+		}
+
+		if !e.inStep() || e.strict {
+			n, err := e.DecodeToUint64(string(data[start:end]))
+			if err != nil {
+				return nil, ErrBatchElement{i, fmt.Errorf("Element %d: %w", i, err)}
+			}
+			vals[i] = n
+			continue
+		}
+
+		// Skip padding characters, which aren't digits
+		v := data[start:end]
+		if e.padChar != 0 {
+			v = bytes.Trim(v, string(e.padChar))
+		}
+
+		var n uint64
+		for j, c := range v {
+			idx := e.index(c)
+			if idx == -1 {
+				return nil, ErrBatchElement{i, fmt.Errorf("Element %d: %w", i, e.invalidCharacter(string(v), j))}
+			}
+			if n > (math.MaxUint64-uint64(idx))/base {
+				return nil, ErrBatchElement{i, fmt.Errorf("Element %d: %w", i, ErrOverflow{fmt.Errorf("Value of %s overflows 64 bits", v)})}
+			}
+			n = n*base + uint64(idx)
+		}
+		vals[i] = n
+	}
+
+	return vals, nil
+}
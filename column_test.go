@@ -0,0 +1,144 @@
+package base62
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeUint64Column(t *testing.T) {
+	vals := []uint64{0, 1, 61, 62, 4815162342, math.MaxUint64}
+
+	data, offsets, err := EncodeUint64Column(vals)
+	require.NoError(t, err)
+	assert.Equal(t, "01z105FrvgkLygHa16AHYF", string(data))
+	assert.Equal(t, []int32{0, 1, 2, 3, 5, 11, 22}, offsets)
+
+	for i, n := range vals {
+		assert.Equal(t, EncodeUint64(n), string(data[offsets[i]:offsets[i+1]]))
+	}
+
+	v, err := DecodeUint64Column(data, offsets)
+	require.NoError(t, err)
+	assert.Equal(t, vals, v)
+}
+
+func TestEncodeUint64ColumnPadded(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(4))
+
+	data, offsets, err := e.EncodeUint64Column([]uint64{1, 4815162342})
+	require.NoError(t, err)
+	assert.Equal(t, "00015Frvgk", string(data))
+	assert.Equal(t, []int32{0, 4, 10}, offsets)
+}
+
+func TestEncodeUint64ColumnPaddingChar(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(4), PaddingChar(' '))
+	vals := []uint64{1, 4815162342}
+
+	data, offsets, err := e.EncodeUint64Column(vals)
+	require.NoError(t, err)
+	assert.Equal(t, "   15Frvgk", string(data))
+	assert.Equal(t, []int32{0, 4, 10}, offsets)
+
+	v, err := e.DecodeUint64Column(data, offsets)
+	require.NoError(t, err)
+	assert.Equal(t, vals, v)
+}
+
+func TestEncodeUint64ColumnEmptyZero(t *testing.T) {
+	e := NewStdEncoding().Option(EmptyZero())
+
+	data, offsets, err := e.EncodeUint64Column([]uint64{0, 1})
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(data))
+	assert.Equal(t, []int32{0, 0, 1}, offsets)
+}
+
+func TestEncodeUint64ColumnEmpty(t *testing.T) {
+	data, offsets, err := EncodeUint64Column(nil)
+	require.NoError(t, err)
+	assert.Empty(t, data)
+	assert.Equal(t, []int32{0}, offsets)
+
+	v, err := DecodeUint64Column(data, offsets)
+	require.NoError(t, err)
+	assert.Empty(t, v)
+}
+
+func TestDecodeUint64ColumnInvalid(t *testing.T) {
+	_, err := DecodeUint64Column(nil, nil)
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeUint64Column([]byte("10"), []int32{0, 3})
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeUint64Column([]byte("10-1"), []int32{0, 2, 4})
+	var elem ErrBatchElement
+	require.ErrorAs(t, err, &elem)
+	assert.Equal(t, 1, elem.Index)
+	var invalid ErrInvalidCharacter
+	require.ErrorAs(t, err, &invalid)
+
+	_, err = DecodeUint64Column([]byte("zzzzzzzzzzzz"), []int32{0, 12})
+	var overflow ErrOverflow
+	require.ErrorAs(t, err, &overflow)
+}
+
+func TestEncodeUint64ColumnOptions(t *testing.T) {
+	encodings := []*Encoding{
+		NewStdEncoding().Option(WithMask(0x5f3759df)),
+		NewStdEncoding().Option(Luhn()),
+		NewStdEncoding().Option(Sortable()),
+		NewStdEncoding().Option(Group(2, "-"), Padding(6)),
+		NewStdEncoding().Option(LetterFirst()),
+		NewStdEncoding().Option(Strict(), Padding(3)),
+	}
+	vals := []uint64{0, 5, 61, 62, 3843, 1 << 40, math.MaxUint64}
+
+	for _, e := range encodings {
+		t.Logf("Encoding %v", e)
+
+		data, offsets, err := e.EncodeUint64Column(vals)
+		require.NoError(t, err)
+		for i, n := range vals {
+			assert.Equal(t, e.EncodeUint64(n), string(data[offsets[i]:offsets[i+1]]))
+		}
+
+		decoded, err := e.DecodeUint64Column(data, offsets)
+		require.NoError(t, err)
+		assert.Equal(t, vals, decoded)
+	}
+
+	e := NewStdEncoding().Option(Luhn())
+	_, err := e.DecodeUint64Column([]byte("11"), []int32{0, 2})
+	var elem ErrBatchElement
+	require.ErrorAs(t, err, &elem)
+	var checksum ErrChecksum
+	require.ErrorAs(t, err, &checksum)
+}
+
+func TestColumnOffset(t *testing.T) {
+	n, err := columnOffset(math.MaxInt32)
+	require.NoError(t, err)
+	assert.Equal(t, int32(math.MaxInt32), n)
+
+	_, err = columnOffset(math.MaxInt32 + 1)
+	assert.IsType(t, ErrOverflow{}, err)
+}
+
+func BenchmarkEncodeUint64Column(b *testing.B) {
+	vals := make([]uint64, 1024)
+	for i := range vals {
+		vals[i] = uint64(i) * 4815162342
+	}
+
+	b.ReportAllocs()
+	var data []byte
+	for i := 0; i < b.N; i++ {
+		data, _, _ = EncodeUint64Column(vals)
+	}
+	result = string(data[:1])
+}
@@ -0,0 +1,98 @@
+package base62
+
+// Compare compares two base62 encoded values numerically using the
+// StdEncoding, see Encoding.Compare
+func Compare(a, b string) (int, error) {
+	return StdEncoding.Compare(a, b)
+}
+
+// Compare compares the numeric values of two base62 encoded strings,
+// returning -1 if a < b, 0 if a == b, and +1 if a > b. Non-negative values
+// of encodings which encode values as their plain digits are compared
+// without decoding them, ignoring leading zero padding, so there is no
+// limit on their magnitude. Negative values, and those of encodings which
+// map or format values, such as with a mask, Permutation or Group, are
+// decoded as int64 values to be compared
+func (e *Encoding) Compare(a, b string) (int, error) {
+	if !e.inStep() || e.hasSign(a) || e.hasSign(b) {
+		return e.compareDecoded(a, b)
+	}
+
+	da, err := e.digits(e.unpad(a))
+	if err != nil {
+		return 0, err
+	}
+	db, err := e.digits(e.unpad(b))
+	if err != nil {
+		return 0, err
+	}
+
+	// Zig-zag encoding interleaves negative values as the odd numbers,
+	// which are all less than the non-negative values and run backwards
+	if e.zigzag {
+		na, nb := negative(da), negative(db)
+		switch {
+		case na && !nb:
+			return -1, nil
+		case !na && nb:
+			return 1, nil
+		case na && nb:
+			return -compareDigits(da, db), nil
+		}
+	}
+
+	return compareDigits(da, db), nil
+}
+
+// compareDecoded compares the decoded int64 values of a and b
+func (e *Encoding) compareDecoded(a, b string) (int, error) {
+	na, err := e.DecodeToInt64(a)
+	if err != nil {
+		return 0, err
+	}
+	nb, err := e.DecodeToInt64(b)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case na < nb:
+		return -1, nil
+	case na > nb:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// compareDigits compares the numeric values of two sets of digits
+func compareDigits(da, db []byte) int {
+	da, db = trimZeros(da), trimZeros(db)
+
+	// With padding removed, a longer value is always greater
+	switch {
+	case len(da) < len(db):
+		return -1
+	case len(da) > len(db):
+		return 1
+	}
+
+	// Otherwise the first differing digit decides
+	for i := range da {
+		switch {
+		case da[i] < db[i]:
+			return -1
+		case da[i] > db[i]:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// trimZeros removes leading zero digits
+func trimZeros(d []byte) []byte {
+	for len(d) > 0 && d[0] == 0 {
+		d = d[1:]
+	}
+	return d
+}
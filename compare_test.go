@@ -0,0 +1,103 @@
+package base62
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	testcases := []struct {
+		a, b   string
+		result int
+	}{
+		{"", "", 0},
+		{"", "0", 0},
+		{"0", "1", -1},
+		{"1", "0", 1},
+		{"9", "A", -1},
+		{"Z", "a", -1},
+		{"z", "10", -1},
+		{"10", "z", 1},
+		{"00010", "10", 0},
+		{"10", "000z", 1},
+		{"5Frvgk", "000005Frvgk", 0},
+		{"5Frvgk", "5Frvgl", -1},
+		{"AzL8n0Y58m7", "7n42DGM5Tflk9n8mt7Fhc7", -1},
+		{"7n42DGM5Tflk9n8mt7Fhc7", "000000000000000000003tX16dB2jpss4tZORYcqoX", 1},
+	}
+
+	for _, tc := range testcases {
+		v, err := Compare(tc.a, tc.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Compared %q with %q as %d", tc.a, tc.b, v)
+		assert.Equal(t, tc.result, v)
+	}
+}
+
+func TestCompareMatchesDecode(t *testing.T) {
+	for _, a := range testcases {
+		for _, b := range testcases {
+			v, err := Compare(a.encoded, b.encoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := 0
+			if a.num < b.num {
+				expected = -1
+			} else if a.num > b.num {
+				expected = 1
+			}
+			assert.Equal(t, expected, v)
+		}
+	}
+}
+
+func TestCompareEncodings(t *testing.T) {
+	testcases := []struct {
+		e *Encoding
+		a int64
+		b int64
+	}{
+		{StdEncoding, -5, 3},
+		{StdEncoding, -5, -3},
+		{StdEncoding, math.MinInt64, 0},
+		{NewStdEncoding().Option(Sign('~')), -62, -1},
+		{NewStdEncoding().Option(Group(2, "-")), 4815162342, 4815162343},
+		{NewStdEncoding().Option(WithMask(1)), 0, 1},
+		{NewStdEncoding().Option(WithMask(0xdeadbeef)), 61, 62},
+		{NewStdEncoding().Option(KnuthHash()), 1, 2},
+		{NewStdEncoding().Option(Luhn()), 9, 10},
+		{NewStdEncoding().Option(ZigZag()), -1, 0},
+	}
+
+	for _, tc := range testcases {
+		a, b := tc.e.EncodeInt64(tc.a), tc.e.EncodeInt64(tc.b)
+		t.Logf("Comparing %d as %q with %d as %q", tc.a, a, tc.b, b)
+
+		v, err := tc.e.Compare(a, b)
+		require.NoError(t, err)
+		assert.Equal(t, -1, v)
+
+		v, err = tc.e.Compare(b, a)
+		require.NoError(t, err)
+		assert.Equal(t, 1, v)
+
+		v, err = tc.e.Compare(a, a)
+		require.NoError(t, err)
+		assert.Equal(t, 0, v)
+	}
+}
+
+func TestCompareInvalid(t *testing.T) {
+	_, err := Compare("10", "1-0")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+
+	_, err = Compare("1_0", "10")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
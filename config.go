@@ -0,0 +1,238 @@
+package base62
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Config describes an Encoding as plain data, as an alternative to
+// functional options for settings loaded from JSON or YAML configuration
+type Config struct {
+	// Alphabet of 62 unique characters, the standard alphabet if empty
+	Alphabet string `json:"alphabet,omitempty" yaml:"alphabet,omitempty"`
+
+	// Padding sets the minimum length of encoded strings
+	Padding int `json:"padding,omitempty" yaml:"padding,omitempty"`
+
+	// ZigZag sets signed int64 values to be zig-zag encoded
+	ZigZag bool `json:"zigzag,omitempty" yaml:"zigzag,omitempty"`
+
+	// LittleEndian interprets byte values as little-endian integers
+	LittleEndian bool `json:"littleEndian,omitempty" yaml:"littleEndian,omitempty"`
+
+	// Group and GroupSeparator format encoded integers for display
+	Group          int    `json:"group,omitempty" yaml:"group,omitempty"`
+	GroupSeparator string `json:"groupSeparator,omitempty" yaml:"groupSeparator,omitempty"`
+
+	// IgnoreSeparators are characters removed before decoding
+	IgnoreSeparators string `json:"ignoreSeparators,omitempty" yaml:"ignoreSeparators,omitempty"`
+
+	// Lenient skips whitespace and hyphens before decoding
+	Lenient bool `json:"lenient,omitempty" yaml:"lenient,omitempty"`
+
+	// Sign is a single character marking negative int64 values
+	Sign string `json:"sign,omitempty" yaml:"sign,omitempty"`
+
+	// EmptyZero encodes zero as an empty string
+	EmptyZero bool `json:"emptyZero,omitempty" yaml:"emptyZero,omitempty"`
+
+	// PaddingChar is a single character padding in place of the zero digit
+	PaddingChar string `json:"paddingChar,omitempty" yaml:"paddingChar,omitempty"`
+
+	// Align is left to pad on the right with the PaddingChar, else right
+	Align string `json:"align,omitempty" yaml:"align,omitempty"`
+
+	// MaxDecodeLen limits the length of strings decoded
+	MaxDecodeLen int `json:"maxDecodeLen,omitempty" yaml:"maxDecodeLen,omitempty"`
+
+	// Luhn appends and verifies a Luhn mod N check character
+	Luhn bool `json:"luhn,omitempty" yaml:"luhn,omitempty"`
+
+	// Strict accepts only the canonical encoding of each value
+	Strict bool `json:"strict,omitempty" yaml:"strict,omitempty"`
+
+	// CaseInsensitive decodes letters regardless of case where unambiguous
+	CaseInsensitive bool `json:"caseInsensitive,omitempty" yaml:"caseInsensitive,omitempty"`
+
+	// Remap are pairs of input characters and the digits they decode as,
+	// where pairs which don't apply to the alphabet are ignored as by Remap
+	Remap string `json:"remap,omitempty" yaml:"remap,omitempty"`
+
+	// Blocklist are words screened from generated IDs
+	Blocklist []string `json:"blocklist,omitempty" yaml:"blocklist,omitempty"`
+
+	// Mask is XORed with integer values around encoding and decoding
+	Mask uint64 `json:"mask,omitempty" yaml:"mask,omitempty"`
+
+	// LetterFirst prefixes the zero digit to integers starting with a digit
+	LetterFirst bool `json:"letterFirst,omitempty" yaml:"letterFirst,omitempty"`
+
+	// Sortable encodes integers at a fixed width in the order of values
+	Sortable bool `json:"sortable,omitempty" yaml:"sortable,omitempty"`
+
+	// KnuthHash spreads int64 values across the keyspace
+	KnuthHash bool `json:"knuthHash,omitempty" yaml:"knuthHash,omitempty"`
+}
+
+// NewFromConfig returns a new Encoding configured from c, returning an
+// error if the configuration is invalid
+func NewFromConfig(c Config) (*Encoding, error) {
+	alphabet := c.Alphabet
+	if alphabet == "" {
+		alphabet = encodeStd
+	}
+	if err := validateAlphabet(alphabet); err != nil {
+		return nil, err
+	}
+
+	if c.Padding < 0 {
+		return nil, ErrInvalidConfig{fmt.Errorf("Padding must not be negative, got %d", c.Padding)}
+	}
+
+	if c.Group < 0 {
+		return nil, ErrInvalidConfig{fmt.Errorf("Group must not be negative, got %d", c.Group)}
+	}
+	if c.Group > 0 && c.GroupSeparator == "" {
+		return nil, ErrInvalidConfig{fmt.Errorf("Group of %d requires a separator", c.Group)}
+	}
+	if i := strings.IndexAny(c.GroupSeparator, alphabet); i != -1 {
+		return nil, ErrInvalidConfig{fmt.Errorf("Group separator %q contains alphabet character %c", c.GroupSeparator, c.GroupSeparator[i])}
+	}
+
+	if i := strings.IndexAny(c.IgnoreSeparators, alphabet); i != -1 {
+		return nil, ErrInvalidConfig{fmt.Errorf("Ignored separators %q contain alphabet character %c", c.IgnoreSeparators, c.IgnoreSeparators[i])}
+	}
+
+	if len(c.Sign) > 1 || strings.ContainsAny(c.Sign, alphabet) {
+		return nil, ErrInvalidConfig{fmt.Errorf("Sign %q must be a single character outside the alphabet", c.Sign)}
+	}
+	if c.Sign == "" && strings.IndexByte(alphabet, '-') != -1 {
+		return nil, ErrInvalidConfig{fmt.Errorf("Alphabet including '-' requires another Sign")}
+	}
+
+	if c.PaddingChar != "" {
+		r, size := utf8.DecodeRuneInString(c.PaddingChar)
+		if size != len(c.PaddingChar) || r == utf8.RuneError || (strings.ContainsRune(alphabet, r) && r != rune(alphabet[0])) {
+			return nil, ErrInvalidConfig{fmt.Errorf("Padding character %q must be a single character outside the alphabet", c.PaddingChar)}
+		}
+		if c.PaddingChar == c.Sign || c.PaddingChar == "-" && c.Sign == "" && !c.ZigZag {
+			return nil, ErrInvalidConfig{fmt.Errorf("Padding character %q must differ from the sign", c.PaddingChar)}
+		}
+	}
+
+	if c.MaxDecodeLen < 0 {
+		return nil, ErrInvalidConfig{fmt.Errorf("Maximum decode length must not be negative, got %d", c.MaxDecodeLen)}
+	}
+
+	if len(c.Remap)%2 != 0 {
+		return nil, ErrInvalidConfig{fmt.Errorf("Remap %q must be pairs of characters", c.Remap)}
+	}
+
+	for _, w := range c.Blocklist {
+		if w == "" {
+			return nil, ErrInvalidConfig{fmt.Errorf("Blocklist words must not be empty")}
+		}
+	}
+
+	if c.LetterFirst && !isLetter(alphabet[0]) {
+		return nil, ErrInvalidConfig{fmt.Errorf("Letter first requires the zero digit to be a letter, got %c", alphabet[0])}
+	}
+
+	if c.Sortable && !byteOrdered(alphabet) {
+		return nil, ErrInvalidConfig{fmt.Errorf("Sortable requires an alphabet in byte order")}
+	}
+
+	align, err := parseAlignment(c.Align)
+	if err != nil {
+		return nil, err
+	}
+	if align == AlignLeft && (c.PaddingChar == "" || c.PaddingChar == alphabet[:1]) {
+		return nil, ErrInvalidConfig{fmt.Errorf("Left alignment requires a padding character outside the alphabet")}
+	}
+
+	e := newEncoding(alphabet).Option(Padding(c.Padding))
+	if c.ZigZag {
+		e.Option(ZigZag())
+	}
+	if c.LittleEndian {
+		e.Option(ByteOrder(binary.LittleEndian))
+	}
+	if c.Group > 0 {
+		e.Option(Group(c.Group, c.GroupSeparator))
+	}
+	if c.IgnoreSeparators != "" {
+		e.Option(IgnoreSeparators(c.IgnoreSeparators))
+	}
+	if c.Lenient {
+		e.Option(Lenient())
+	}
+	if c.Sign != "" {
+		e.Option(Sign(c.Sign[0]))
+	}
+	if c.EmptyZero {
+		e.Option(EmptyZero())
+	}
+	if c.PaddingChar != "" {
+		r, _ := utf8.DecodeRuneInString(c.PaddingChar)
+		e.Option(PaddingChar(r))
+	}
+	if align != AlignRight {
+		e.Option(Align(align))
+	}
+	if c.MaxDecodeLen > 0 {
+		e.Option(MaxDecodeLen(c.MaxDecodeLen))
+	}
+	if c.Luhn {
+		e.Option(Luhn())
+	}
+	if c.Strict {
+		e.Option(Strict())
+	}
+	if c.CaseInsensitive {
+		e.Option(CaseInsensitive())
+	}
+	if c.Remap != "" {
+		e.Option(Remap(c.Remap))
+	}
+	if len(c.Blocklist) > 0 {
+		e.Option(Blocklist(c.Blocklist...))
+	}
+	if c.Mask != 0 {
+		e.Option(WithMask(c.Mask))
+	}
+	if c.LetterFirst {
+		e.Option(LetterFirst())
+	}
+	if c.Sortable {
+		e.Option(Sortable())
+	}
+	if c.KnuthHash {
+		e.Option(KnuthHash())
+	}
+
+	return e, nil
+}
+
+// validateAlphabet checks an alphabet consists of 62 unique printable ASCII
+// characters, excluding the space
+func validateAlphabet(alphabet string) error {
+	if len(alphabet) != base {
+		return ErrInvalidConfig{fmt.Errorf("Alphabet must be %d characters, got %d", base, len(alphabet))}
+	}
+
+	var seen [256]bool
+	for i := 0; i < len(alphabet); i++ {
+		c := alphabet[i]
+		if c <= ' ' || c > '~' {
+			return ErrInvalidConfig{fmt.Errorf("Alphabet contains unprintable character %q at %d", c, i)}
+		}
+		if seen[c] {
+			return ErrInvalidConfig{fmt.Errorf("Alphabet contains duplicate character %c at %d", c, i)}
+		}
+		seen[c] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package base62
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFromConfig(t *testing.T) {
+	e, err := NewFromConfig(Config{})
+	require.NoError(t, err)
+	assert.Equal(t, "5Frvgk", e.EncodeInt64(4815162342))
+
+	e, err = NewFromConfig(Config{Padding: 15})
+	require.NoError(t, err)
+	assert.Equal(t, "0000000005Frvgk", e.EncodeInt64(4815162342))
+
+	e, err = NewFromConfig(Config{ZigZag: true})
+	require.NoError(t, err)
+	assert.Equal(t, "1", e.EncodeInt64(-1))
+
+	e, err = NewFromConfig(Config{KnuthHash: true})
+	require.NoError(t, err)
+	assert.True(t, NewStdEncoding().Option(KnuthHash()).Equal(e))
+}
+
+func TestNewFromConfigJSON(t *testing.T) {
+	var c Config
+	err := json.Unmarshal([]byte(`{
+		"alphabet": "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789",
+		"padding": 2
+	}`), &c)
+	require.NoError(t, err)
+
+	e, err := NewFromConfig(c)
+	require.NoError(t, err)
+	assert.Equal(t, "bk", e.EncodeInt64(72))
+}
+
+func TestNewFromConfigInvalid(t *testing.T) {
+	testcases := []Config{
+		{Alphabet: "0123456789"},
+		{Alphabet: "0023456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"},
+		{Alphabet: " 123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"},
+		{Alphabet: "\x00123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"},
+		{Padding: -1},
+		{Group: -1},
+		{Group: 4},
+		{Group: 4, GroupSeparator: "a"},
+		{IgnoreSeparators: "-a"},
+		{Sign: "--"},
+		{Sign: "a"},
+		{PaddingChar: "__"},
+		{PaddingChar: "a"},
+		{PaddingChar: "-", Sign: "-"},
+		{PaddingChar: "-"},
+		{Align: "centre"},
+		{Align: "left"},
+		{Align: "left", PaddingChar: "0"},
+		{MaxDecodeLen: -1},
+		{Remap: "-"},
+		{Blocklist: []string{""}},
+		{LetterFirst: true},
+		{Sortable: true, Alphabet: encodeLower},
+	}
+
+	for _, c := range testcases {
+		_, err := NewFromConfig(c)
+		t.Logf("Config %+v failed with %v", c, err)
+		assert.IsType(t, ErrInvalidConfig{}, err)
+	}
+}
@@ -0,0 +1,195 @@
+// Package core implements a minimal base62 codec for int64, uint64 and
+// byte values, without allocating and without depending on fmt, strconv or
+// math/big, to minimise binary size for WASM and firmware builds.
+//
+// Invalid input is reported with a boolean rather than a formatted error.
+// The base62 package builds its full featured Encoding on this Codec, which
+// encodes the plain digits beneath its options
+package core
+
+import "math"
+
+const base = 62
+
+// MaxLenUint64 is the maximum length of an encoded uint64
+const MaxLenUint64 = 11
+
+// StdAlphabet is the standard base62 alphabet, which preserves the sort
+// order of encoded values
+const StdAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// Codec encodes and decodes values using a 62 character alphabet
+type Codec struct {
+	encode [base]byte
+	decode [256]byte
+}
+
+const invalid = 0xff
+
+// Std is the Codec for the standard alphabet
+var Std, _ = NewCodec(StdAlphabet)
+
+// NewCodec returns a Codec for the given alphabet, which must consist of
+// 62 unique characters
+func NewCodec(alphabet string) (*Codec, bool) {
+	if len(alphabet) != base {
+		return nil, false
+	}
+
+	c := &Codec{}
+	for i := range c.decode {
+		c.decode[i] = invalid
+	}
+	for i := 0; i < base; i++ {
+		if c.decode[alphabet[i]] != invalid {
+			return nil, false
+		}
+		c.encode[i] = alphabet[i]
+		c.decode[alphabet[i]] = byte(i)
+	}
+
+	return c, true
+}
+
+// AppendUint64 appends the base62 encoding of n to dst, zero is encoded
+// as the first character of the alphabet
+func (c *Codec) AppendUint64(dst []byte, n uint64) []byte {
+	if n == 0 {
+		return append(dst, c.encode[0])
+	}
+
+	var (
+		buf [MaxLenUint64]byte
+		i   = len(buf)
+	)
+
+	// Progressively divide by base, filling in digits
+	// from the least significant upwards
+	for n > 0 {
+		i--
+		buf[i] = c.encode[n%base]
+		n /= base
+	}
+
+	return append(dst, buf[i:]...)
+}
+
+// AppendInt64 appends the base62 encoding of n to dst, returning false
+// with dst unchanged if n is negative, which has no encoding
+func (c *Codec) AppendInt64(dst []byte, n int64) ([]byte, bool) {
+	if n < 0 {
+		return dst, false
+	}
+	return c.AppendUint64(dst, uint64(n)), true
+}
+
+// DecodeUint64 decodes a base62 encoded value, returning false if it is
+// empty, contains an invalid character or overflows a uint64
+func (c *Codec) DecodeUint64(src []byte) (uint64, bool) {
+	if len(src) == 0 {
+		return 0, false
+	}
+
+	var n uint64
+
+	for _, v := range src {
+		d := c.decode[v]
+		if d == invalid {
+			return 0, false
+		}
+		if n > (math.MaxUint64-uint64(d))/base {
+			return 0, false
+		}
+		n = n*base + uint64(d)
+	}
+
+	return n, true
+}
+
+// DecodeInt64 decodes a base62 encoded value, returning false if it is
+// empty, contains an invalid character or overflows an int64
+func (c *Codec) DecodeInt64(src []byte) (int64, bool) {
+	n, ok := c.DecodeUint64(src)
+	if !ok || n > math.MaxInt64 {
+		return 0, false
+	}
+	return int64(n), true
+}
+
+// EncodedLen returns the fixed width encoded length of n bytes
+func EncodedLen(n int) int {
+	return int(math.Ceil(float64(n*8) / math.Log2(base)))
+}
+
+// scratchLen is the size of byte values encoded without allocating
+const scratchLen = 64
+
+// AppendBytes appends the fixed width base62 encoding of src, interpreted
+// as a big-endian unsigned integer, to dst. Values of up to 64 bytes are
+// encoded without allocating beyond the growth of dst
+func (c *Codec) AppendBytes(dst, src []byte) []byte {
+	var (
+		scratch [scratchLen]byte
+		n       []byte
+	)
+	if len(src) <= scratchLen {
+		n = scratch[:len(src)]
+	} else {
+		n = make([]byte, len(src))
+	}
+	copy(n, src)
+
+	w := EncodedLen(len(src))
+	start := len(dst)
+	for i := 0; i < w; i++ {
+		dst = append(dst, 0)
+	}
+
+	// Progressively divide the bytes by our base using long division,
+	// filling in digits from the least significant upwards
+	for i := start + w - 1; i >= start; i-- {
+		var rem uint
+		for j, v := range n {
+			acc := rem<<8 | uint(v)
+			n[j] = byte(acc / base)
+			rem = acc % base
+		}
+		dst[i] = c.encode[rem]
+	}
+
+	return dst
+}
+
+// DecodeBytes decodes a fixed width base62 encoding into dst, src must be
+// EncodedLen(len(dst)) characters long. It returns false if src is the
+// wrong length, contains an invalid character, or overflows dst
+func (c *Codec) DecodeBytes(dst, src []byte) bool {
+	if len(src) != EncodedLen(len(dst)) {
+		return false
+	}
+
+	for i := range dst {
+		dst[i] = 0
+	}
+
+	for _, v := range src {
+		d := c.decode[v]
+		if d == invalid {
+			return false
+		}
+
+		// Multiply the bytes by our base and add the digit,
+		// carrying upwards from the least significant byte
+		carry := uint(d)
+		for j := len(dst) - 1; j >= 0; j-- {
+			acc := uint(dst[j])*base + carry
+			dst[j] = byte(acc)
+			carry = acc >> 8
+		}
+		if carry != 0 {
+			return false
+		}
+	}
+
+	return true
+}
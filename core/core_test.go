@@ -0,0 +1,123 @@
+package core
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testcases = []struct {
+	num     uint64
+	encoded string
+}{
+	{0, "0"},
+	{1, "1"},
+	{10, "A"},
+	{61, "z"},
+	{62, "10"},
+	{3844, "100"},
+	{4815162342, "5Frvgk"},
+	{math.MaxInt64, "AzL8n0Y58m7"},
+	{math.MaxUint64, "LygHa16AHYF"},
+}
+
+func TestAppendUint64(t *testing.T) {
+	for _, tc := range testcases {
+		v := Std.AppendUint64([]byte("id:"), tc.num)
+		assert.Equal(t, "id:"+tc.encoded, string(v))
+	}
+}
+
+func TestDecodeUint64(t *testing.T) {
+	for _, tc := range testcases {
+		v, ok := Std.DecodeUint64([]byte(tc.encoded))
+		assert.True(t, ok)
+		assert.Equal(t, tc.num, v)
+	}
+
+	_, ok := Std.DecodeUint64([]byte("LygHa16AHYG"))
+	assert.False(t, ok)
+
+	_, ok = Std.DecodeUint64([]byte("5Frv-gk"))
+	assert.False(t, ok)
+
+	_, ok = Std.DecodeUint64(nil)
+	assert.False(t, ok)
+}
+
+func TestInt64(t *testing.T) {
+	v, ok := Std.AppendInt64(nil, 4815162342)
+	assert.True(t, ok)
+	assert.Equal(t, "5Frvgk", string(v))
+
+	v, ok = Std.AppendInt64([]byte("id:"), -1)
+	assert.False(t, ok)
+	assert.Equal(t, "id:", string(v))
+
+	n, ok := Std.DecodeInt64([]byte("AzL8n0Y58m7"))
+	assert.True(t, ok)
+	assert.Equal(t, int64(math.MaxInt64), n)
+
+	_, ok = Std.DecodeInt64([]byte("AzL8n0Y58m8"))
+	assert.False(t, ok)
+}
+
+func TestBytes(t *testing.T) {
+	testcases := []struct {
+		src     []byte
+		encoded string
+	}{
+		{[]byte{}, ""},
+		{[]byte{0, 0, 0, 1}, "000001"},
+		{bytes.Repeat([]byte{0xff}, 16), "7n42DGM5Tflk9n8mt7Fhc7"},
+		{bytes.Repeat([]byte{0xab}, 100), ""},
+	}
+
+	for _, tc := range testcases {
+		v := Std.AppendBytes(nil, tc.src)
+		assert.Len(t, v, EncodedLen(len(tc.src)))
+		if tc.encoded != "" {
+			assert.Equal(t, tc.encoded, string(v))
+		}
+
+		dst := make([]byte, len(tc.src))
+		assert.True(t, Std.DecodeBytes(dst, v))
+		assert.Equal(t, tc.src, dst)
+	}
+
+	dst := make([]byte, 16)
+	assert.False(t, Std.DecodeBytes(dst, []byte("7n42DGM5Tflk9n8mt7Fhc8")))
+	assert.False(t, Std.DecodeBytes(dst, []byte("7n42DGM5Tflk9n8mt7Fhc")))
+	assert.False(t, Std.DecodeBytes(dst, []byte("7n42DGM5Tflk9n8mt7Fhc-")))
+}
+
+func TestNewCodec(t *testing.T) {
+	c, ok := NewCodec("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
+	assert.True(t, ok)
+	assert.Equal(t, "bk", string(c.AppendUint64(nil, 72)))
+	assert.Equal(t, "a", string(c.AppendUint64(nil, 0)))
+
+	_, ok = NewCodec("0123456789")
+	assert.False(t, ok)
+
+	_, ok = NewCodec("0023456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz")
+	assert.False(t, ok)
+}
+
+func TestAllocs(t *testing.T) {
+	var (
+		buf = make([]byte, 0, 64)
+		src = bytes.Repeat([]byte{0xff}, 16)
+		dst = make([]byte, 16)
+	)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = Std.AppendUint64(buf[:0], math.MaxUint64)
+		Std.DecodeUint64(buf)
+		buf = Std.AppendBytes(buf[:0], src)
+		Std.DecodeBytes(dst, buf)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
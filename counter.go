@@ -0,0 +1,135 @@
+package base62
+
+import (
+	"math"
+	"sync"
+)
+
+// Counter is a monotonically increasing sequence which maintains its base62
+// representation incrementally, propagating carries through the encoded
+// digits rather than re-encoding the value from scratch on every increment.
+// Negative values, and those of encodings whose digits don't count with the
+// value, such as with a Permutation, are re-encoded in full each time
+type Counter struct {
+	mu  sync.Mutex
+	e   *Encoding
+	n   int64
+	idx []byte // alphabet index of each digit, most significant first
+	buf []byte // encoded digits, unpadded
+
+	// incremental is false where the digits don't move in step with values
+	incremental bool
+}
+
+// NewCounter returns a Counter starting at n using the StdEncoding
+func NewCounter(n int64) *Counter {
+	return StdEncoding.NewCounter(n)
+}
+
+// NewCounter returns a Counter starting at n
+func (e *Encoding) NewCounter(n int64) *Counter {
+	c := &Counter{
+		e:           e,
+		n:           n,
+		incremental: e.inStep(),
+	}
+	if c.incremental && n >= 0 {
+		c.reset()
+	}
+
+	return c
+}
+
+// Next increments the counter, returning the new value and its encoding,
+// skipping values whose encodings contain words of the Blocklist. It
+// panics if the counter would overflow an int64
+func (c *Counter) Next() (int64, string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Skip over any values whose encodings contain blocked words
+	for {
+		if c.n == math.MaxInt64 {
+			panic("base62: counter overflow")
+		}
+		c.n++
+
+		switch {
+		case !c.incremental || c.n < 0:
+		case c.n == 0:
+			// Counting up from a negative start, the digits begin at zero
+			c.reset()
+		default:
+			// Zig-zag encoding steps the encoded value by more than one
+			for i := uint64(0); i < c.e.unit(); i++ {
+				c.increment()
+			}
+		}
+
+		if s := c.string(); !c.e.blocked(s) {
+			return c.n, s
+		}
+	}
+}
+
+// Value returns the current value of the counter
+func (c *Counter) Value() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.n
+}
+
+// String returns the encoding of the current value of the counter
+func (c *Counter) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.string()
+}
+
+// reset encodes the digits of the current value from scratch
+func (c *Counter) reset() {
+	c.buf = []byte(c.e.encodeInt64(c.n))
+
+	c.idx = make([]byte, len(c.buf))
+	for i, v := range c.buf {
+		c.idx[i] = byte(c.e.index(v))
+	}
+}
+
+// increment adds one to the encoded digits
+func (c *Counter) increment() {
+	// Increment the least significant digit, carrying upwards
+	// while digits wrap back around to zero
+	i := len(c.idx) - 1
+	for ; i >= 0; i-- {
+		c.idx[i]++
+		if c.idx[i] < base {
+			c.buf[i] = c.e.encode[c.idx[i]]
+			break
+		}
+		c.idx[i] = 0
+		c.buf[i] = c.e.encode[0]
+	}
+
+	// Carried out of the most significant digit, so prepend a new one
+	if i < 0 {
+		c.idx = append([]byte{1}, c.idx...)
+		c.buf = append([]byte{c.e.encode[1]}, c.buf...)
+	}
+}
+
+func (c *Counter) string() string {
+	if !c.incremental || c.n < 0 {
+		return c.e.EncodeInt64(c.n)
+	}
+
+	s := string(c.buf)
+	if s == "" {
+		s = c.e.zero()
+	}
+	s = c.e.padValue(s, c.e.padding)
+
+	return s
+}
@@ -0,0 +1,134 @@
+package base62
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	starts := []int64{0, 1, 60, 61, 3842, 3843, 238327, 4815162342}
+
+	for _, start := range starts {
+		c := NewCounter(start)
+		assert.Equal(t, EncodeInt64(start), c.String())
+
+		for i := int64(1); i <= 200; i++ {
+			n, s := c.Next()
+			assert.Equal(t, start+i, n)
+			assert.Equal(t, EncodeInt64(start+i), s)
+		}
+		assert.Equal(t, start+200, c.Value())
+	}
+}
+
+func TestCounterPadded(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(6))
+	c := e.NewCounter(61)
+
+	n, s := c.Next()
+	assert.Equal(t, int64(62), n)
+	assert.Equal(t, "000010", s)
+	assert.Equal(t, "000010", c.String())
+}
+
+func TestCounterConcurrent(t *testing.T) {
+	var (
+		c    = NewCounter(0)
+		wg   sync.WaitGroup
+		seen sync.Map
+	)
+
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				n, s := c.Next()
+				if _, dup := seen.LoadOrStore(s, n); dup {
+					t.Errorf("Duplicate value %s", s)
+				}
+				assert.Equal(t, EncodeInt64(n), s)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(8000), c.Value())
+	assert.Equal(t, EncodeInt64(8000), c.String())
+}
+
+func TestCounterOverflow(t *testing.T) {
+	c := NewCounter(math.MaxInt64 - 1)
+
+	n, s := c.Next()
+	assert.Equal(t, int64(math.MaxInt64), n)
+	assert.Equal(t, "AzL8n0Y58m7", s)
+	assert.Panics(t, func() { c.Next() })
+}
+
+func TestCounterOptions(t *testing.T) {
+	testCases := [][]option{
+		{WithMask(0xff)},
+		{KnuthHash()},
+		{Luhn()},
+		{Sortable()},
+		{Group(2, "-"), Padding(6)},
+		{LetterFirst()},
+		{ZigZag(), Padding(4), PaddingChar('_')},
+	}
+
+	for _, opts := range testCases {
+		e := NewEncoding(encodeDigitsLast).Option(opts...)
+		c := e.NewCounter(58)
+		assert.Equal(t, e.EncodeInt64(58), c.String())
+
+		for i := int64(59); i < 200; i++ {
+			n, s := c.Next()
+			assert.Equal(t, i, n)
+			assert.Equal(t, e.EncodeInt64(i), s, e.String())
+
+			v, err := e.DecodeToInt64(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, i, v)
+		}
+	}
+}
+
+func TestCounterNegative(t *testing.T) {
+	encodings := []*Encoding{
+		StdEncoding,
+		NewStdEncoding().Option(Padding(4)),
+		NewStdEncoding().Option(ZigZag()),
+		NewStdEncoding().Option(KnuthHash()),
+	}
+
+	for _, e := range encodings {
+		c := e.NewCounter(-70)
+		assert.Equal(t, e.EncodeInt64(-70), c.String())
+
+		// Counts up through zero, then onwards digit by digit
+		for i := int64(-69); i < 70; i++ {
+			n, s := c.Next()
+			assert.Equal(t, i, n)
+			assert.Equal(t, e.EncodeInt64(i), s, e.String())
+		}
+	}
+}
+
+func BenchmarkCounterNext(b *testing.B) {
+	var (
+		c = NewCounter(4815162342)
+		s string
+	)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, s = c.Next()
+	}
+	result = s
+}
@@ -0,0 +1,67 @@
+package base62
+
+import (
+	"fmt"
+)
+
+// crcWidth is the encoded width of a CRC-16, as 62^3 exceeds 2^16
+const crcWidth = 3
+
+// EncodeBytesCRC encodes bytes with a trailing CRC using the StdEncoding,
+// see Encoding.EncodeBytesCRC
+func EncodeBytesCRC(b []byte) string {
+	return StdEncoding.EncodeBytesCRC(b)
+}
+
+// DecodeBytesCRC decodes bytes with a trailing CRC using the StdEncoding,
+// see Encoding.DecodeBytesCRC
+func DecodeBytesCRC(s string) ([]byte, error) {
+	return StdEncoding.DecodeBytesCRC(s)
+}
+
+// EncodeBytesCRC returns the encoding of b as with EncodeBytes, followed by
+// the CRC-16/CCITT-FALSE of b in 3 characters, so corruption of tokens
+// passed through lossy channels can be detected when decoding
+func (e *Encoding) EncodeBytesCRC(b []byte) string {
+	return e.EncodeBytes(b) + e.pad(e.encodeUint64(uint64(crc16(b))), crcWidth)
+}
+
+// DecodeBytesCRC decodes a string produced by EncodeBytesCRC, returning an
+// ErrChecksum if the CRC doesn't match the decoded bytes
+func (e *Encoding) DecodeBytesCRC(s string) ([]byte, error) {
+	if len(s) < crcWidth {
+		return nil, ErrInvalidLength{fmt.Errorf("Encoded length %d is too short to include a CRC", len(s))}
+	}
+
+	crc, pos, _ := e.parseUint64(s[len(s)-crcWidth:])
+	if pos != -1 {
+		return nil, e.invalidCharacter(s, len(s)-crcWidth+pos)
+	}
+
+	b, err := e.DecodeBytes(s[:len(s)-crcWidth])
+	if err != nil {
+		return nil, err
+	}
+	if want := crc16(b); crc != uint64(want) {
+		return nil, ErrChecksum{fmt.Errorf("CRC %04x of %s does not match decoded bytes, expected %04x", crc, s, want)}
+	}
+
+	return b, nil
+}
+
+// crc16 returns the CRC-16/CCITT-FALSE of b, with polynomial 0x1021 and
+// initial value 0xffff
+func crc16(b []byte) uint16 {
+	crc := uint16(0xffff)
+	for _, v := range b {
+		crc ^= uint16(v) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,61 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRC16(t *testing.T) {
+	// The standard check value of CRC-16/CCITT-FALSE
+	assert.Equal(t, uint16(0x29b1), crc16([]byte("123456789")))
+	assert.Equal(t, uint16(0xffff), crc16(nil))
+}
+
+func TestEncodeBytesCRC(t *testing.T) {
+	for _, b := range [][]byte{
+		{},
+		{0},
+		[]byte("123456789"),
+		{0xde, 0xad, 0xbe, 0xef, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b},
+	} {
+		s := EncodeBytesCRC(b)
+		t.Logf("Encoded %x as %s", b, s)
+		assert.Equal(t, EncodedLen(len(b))+3, len(s))
+		assert.Equal(t, EncodeBytes(b), s[:len(s)-3])
+
+		v, err := DecodeBytesCRC(s)
+		require.NoError(t, err)
+		assert.Equal(t, string(b), string(v))
+	}
+
+	// The CRC of 123456789 is 0x29b1, 10673 or 2m9
+	assert.Equal(t, "2m9", EncodeBytesCRC([]byte("123456789"))[EncodedLen(9):])
+}
+
+func TestDecodeBytesCRCErrors(t *testing.T) {
+	s := EncodeBytesCRC([]byte("123456789"))
+
+	// Corrupting any character is detected
+	for i := 0; i < len(s); i++ {
+		c := byte('0')
+		if s[i] == c {
+			c = '1'
+		}
+		corrupt := s[:i] + string(c) + s[i+1:]
+		_, err := DecodeBytesCRC(corrupt)
+		assert.Error(t, err, corrupt)
+	}
+
+	_, err := DecodeBytesCRC(s[:len(s)-1] + "0")
+	assert.IsType(t, ErrChecksum{}, err)
+
+	_, err = DecodeBytesCRC("2m")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeBytesCRC(s[:len(s)-1] + "-")
+	var invalid ErrInvalidCharacter
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, len(s)-1, invalid.Offset)
+}
@@ -0,0 +1,54 @@
+package base62
+
+import (
+	"slices"
+)
+
+// DetectEncoding returns the preset encodings, such as std or lower, which
+// are consistent with sample values encoded by another base62 library, as
+// a best effort aid to migrating data. Where the numeric values of the
+// samples are known they are given as values, matched by index, and only
+// encodings decoding every sample to its value are returned. Otherwise
+// every encoding able to decode all of the samples is returned, which may
+// be several. The std preset, first if present, is also the ordering of
+// GMP. Encodings with shuffled alphabets cannot be identified
+func DetectEncoding(samples []string, values []int64) []*Encoding {
+	if values != nil && len(values) != len(samples) {
+		return nil
+	}
+
+	// Check std first, as the most common ordering, then the other
+	// presets in order of name
+	names := []string{"std"}
+	for name := range presets {
+		if name != "std" {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names[1:])
+
+	var matches []*Encoding
+	for _, name := range names {
+		e := NewEncoding(presets[name])
+		if e.consistent(samples, values) {
+			matches = append(matches, e)
+		}
+	}
+
+	return matches
+}
+
+// consistent returns whether every sample decodes, to its value if known
+func (e *Encoding) consistent(samples []string, values []int64) bool {
+	for i, s := range samples {
+		n, err := e.DecodeToInt64(s)
+		if err != nil {
+			return false
+		}
+		if values != nil && n != values[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,48 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func specs(encodings []*Encoding) []string {
+	var s []string
+	for _, e := range encodings {
+		spec, _ := e.Spec()
+		s = append(s, spec)
+	}
+	return s
+}
+
+func TestDetectEncoding(t *testing.T) {
+	lower := NewEncoding(encodeLower)
+
+	testcases := []struct {
+		samples []string
+		values  []int64
+		specs   []string
+	}{
+		{[]string{"5Frvgk", "10"}, []int64{4815162342, 62}, []string{"std"}},
+		{[]string{lower.EncodeInt64(4815162342), "10"}, []int64{4815162342, 62}, []string{"lower"}},
+		{[]string{"5Frvgk"}, nil, []string{"std", "digitslast", "lower"}},
+		{[]string{"123"}, nil, []string{"std", "digitslast", "lower"}},
+		{[]string{"FP15qu"}, []int64{4815162342}, []string{"digitslast"}},
+		{[]string{"5Frvgk"}, []int64{1}, nil},
+		{[]string{"5Frv-gk"}, nil, nil},
+		{[]string{"5Frvgk"}, []int64{1, 2}, nil},
+	}
+
+	for _, tc := range testcases {
+		matches := DetectEncoding(tc.samples, tc.values)
+		t.Logf("Detected %v from %v", specs(matches), tc.samples)
+		assert.Equal(t, tc.specs, specs(matches))
+	}
+}
+
+func TestLowerPreset(t *testing.T) {
+	e, err := ParseSpec("lower")
+	require.NoError(t, err)
+	assert.Equal(t, "5fRVGK", e.EncodeInt64(4815162342))
+}
@@ -0,0 +1,92 @@
+package base62
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// Environment variables read by EncodingFromEnv and UseEncodingFromEnv
+const (
+	// EnvAlphabet names the preset alphabet of the encoding, eg. std
+	EnvAlphabet = "BASE62_ALPHABET"
+
+	// EnvPadding sets the padding of the encoding
+	EnvPadding = "BASE62_PADDING"
+)
+
+// EncodingFromEnv returns a new encoding configured from the EnvAlphabet
+// and EnvPadding environment variables, so fleets can switch encodings
+// without code changes. Unset variables keep their standard defaults. The
+// StdEncoding is left unchanged, and the encoding returned is immutable, as
+// a snapshot of the environment which is safe to share, eg.
+//
+//	var ids, _ = base62.EncodingFromEnv()
+//
+// Use UseEncodingFromEnv to install it as the StdEncoding instead
+func EncodingFromEnv() (*Encoding, error) {
+	var c Config
+	if v, ok := os.LookupEnv(EnvAlphabet); ok {
+		alphabet, ok := presets[v]
+		if !ok {
+			return nil, ErrInvalidConfig{fmt.Errorf("%s names unknown alphabet %q", EnvAlphabet, v)}
+		}
+		c.Alphabet = alphabet
+	}
+	if v, ok := os.LookupEnv(EnvPadding); ok {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, ErrInvalidConfig{fmt.Errorf("%s %q is not a number", EnvPadding, v)}
+		}
+		c.Padding = n
+	}
+
+	e, err := NewFromConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return e.freeze(), nil
+}
+
+// defaults guards installing the StdEncoding from the environment
+var defaults struct {
+	sync.Mutex
+	installed bool
+	locked    bool
+}
+
+// UseEncodingFromEnv replaces the StdEncoding, and so the encoding used by
+// the package level functions, with the EncodingFromEnv. This is opt-in, and
+// may only be done once, before LockDefaultEncoding; later calls return an
+// ErrInvalidConfig. The StdEncoding is read without synchronisation, so call
+// this from main or an init function before the package is otherwise used
+func UseEncodingFromEnv() error {
+	defaults.Lock()
+	defer defaults.Unlock()
+
+	if defaults.locked {
+		return ErrInvalidConfig{fmt.Errorf("Default encoding is locked")}
+	}
+	if defaults.installed {
+		return ErrInvalidConfig{fmt.Errorf("Default encoding is already configured from the environment")}
+	}
+
+	e, err := EncodingFromEnv()
+	if err != nil {
+		return err
+	}
+	StdEncoding = e
+	defaults.installed = true
+	return nil
+}
+
+// LockDefaultEncoding prevents any further UseEncodingFromEnv, and returns
+// the StdEncoding in use as an immutable snapshot of the configuration
+func LockDefaultEncoding() *Encoding {
+	defaults.Lock()
+	defer defaults.Unlock()
+
+	defaults.locked = true
+	return StdEncoding
+}
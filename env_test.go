@@ -0,0 +1,109 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodingFromEnv(t *testing.T) {
+	t.Setenv(EnvAlphabet, "std")
+	t.Setenv(EnvPadding, "8")
+
+	e, err := EncodingFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, "005Frvgk", e.EncodeInt64(4815162342))
+	assert.Equal(t, int64(4815162342), e.MustDecodeToInt64("005Frvgk"))
+
+	// The StdEncoding is left unchanged
+	assert.Equal(t, "5Frvgk", EncodeInt64(4815162342))
+	assert.True(t, NewStdEncoding().Equal(StdEncoding))
+}
+
+func TestEncodingFromEnvUnset(t *testing.T) {
+	e, err := EncodingFromEnv()
+	require.NoError(t, err)
+	assert.True(t, NewStdEncoding().Equal(e))
+}
+
+func TestEncodingFromEnvImmutable(t *testing.T) {
+	t.Setenv(EnvPadding, "4")
+
+	e, err := EncodingFromEnv()
+	require.NoError(t, err)
+
+	// Options configure a copy, leaving the snapshot unchanged
+	c := e.Option(Padding(8))
+	assert.Equal(t, 8, c.Padding())
+	assert.Equal(t, 4, e.Padding())
+}
+
+func TestEncodingFromEnvInvalid(t *testing.T) {
+	t.Setenv(EnvAlphabet, "unknown")
+	_, err := EncodingFromEnv()
+	assert.IsType(t, ErrInvalidConfig{}, err)
+
+	t.Setenv(EnvAlphabet, "std")
+	t.Setenv(EnvPadding, "eight")
+	_, err = EncodingFromEnv()
+	assert.IsType(t, ErrInvalidConfig{}, err)
+
+	t.Setenv(EnvPadding, "-8")
+	_, err = EncodingFromEnv()
+	assert.IsType(t, ErrInvalidConfig{}, err)
+}
+
+// resetDefaults restores the StdEncoding and its install state after a test
+func resetDefaults(t *testing.T) {
+	std := StdEncoding
+	t.Cleanup(func() {
+		StdEncoding = std
+		defaults.installed, defaults.locked = false, false
+	})
+}
+
+func TestUseEncodingFromEnv(t *testing.T) {
+	resetDefaults(t)
+	t.Setenv(EnvPadding, "8")
+
+	require.NoError(t, UseEncodingFromEnv())
+	assert.Equal(t, "005Frvgk", EncodeInt64(4815162342))
+	assert.Equal(t, int64(4815162342), MustDecodeToInt64("005Frvgk"))
+
+	// The default is installed only once
+	t.Setenv(EnvPadding, "4")
+	err := UseEncodingFromEnv()
+	assert.IsType(t, ErrInvalidConfig{}, err)
+	assert.Equal(t, 8, StdEncoding.Padding())
+
+	e := LockDefaultEncoding()
+	assert.Equal(t, 8, e.Padding())
+	e.Option(Padding(4))
+	assert.Equal(t, 8, StdEncoding.Padding())
+}
+
+func TestUseEncodingFromEnvLocked(t *testing.T) {
+	resetDefaults(t)
+	t.Setenv(EnvPadding, "8")
+
+	e := LockDefaultEncoding()
+	assert.True(t, NewStdEncoding().Equal(e))
+
+	err := UseEncodingFromEnv()
+	assert.IsType(t, ErrInvalidConfig{}, err)
+	assert.Equal(t, "5Frvgk", EncodeInt64(4815162342))
+}
+
+func TestUseEncodingFromEnvInvalid(t *testing.T) {
+	resetDefaults(t)
+	t.Setenv(EnvAlphabet, "unknown")
+
+	err := UseEncodingFromEnv()
+	assert.IsType(t, ErrInvalidConfig{}, err)
+	assert.True(t, NewStdEncoding().Equal(StdEncoding))
+
+	// A failed install may be retried once the environment is fixed
+	t.Setenv(EnvAlphabet, "std")
+	require.NoError(t, UseEncodingFromEnv())
+}
@@ -0,0 +1,218 @@
+package base62
+
+import (
+	"errors"
+)
+
+/**
+ * Errors are distinct types, matched with errors.As to inspect them, or
+ * with errors.Is against their zero values, such as
+ *
+ *	errors.Is(err, base62.ErrOverflow{})
+ *
+ * which match any error of the type, however it is wrapped. The zero values
+ * are safe to format, describing the type of error
+ */
+
+// errorString returns the message of the wrapped error, or describes the
+// type of error for zero values
+func errorString(err error, desc string) string {
+	if err == nil {
+		return "base62: " + desc
+	}
+	return err.Error()
+}
+
+// ErrInvalidCharacter reports a character outside of the alphabet. Where
+// the character is plausibly a transcription error of characters in the
+// alphabet, such as O for 0, these are included as candidates
+type ErrInvalidCharacter struct {
+	error
+
+	// Char is the invalid character, found at byte Offset of the input
+	Char   rune
+	Offset int
+
+	// Candidates are the characters of the alphabet Char may be a typo of
+	Candidates []rune
+
+	// Suggestion is the input with every invalid character replaced by its
+	// most likely candidate, empty if any invalid character has none
+	Suggestion string
+}
+
+// Error returns the error message
+func (e ErrInvalidCharacter) Error() string {
+	return errorString(e.error, "invalid character")
+}
+
+// Is reports whether target is an ErrInvalidCharacter, for errors.Is
+func (ErrInvalidCharacter) Is(target error) bool {
+	_, ok := target.(ErrInvalidCharacter)
+	return ok
+}
+
+// ErrOverflow reports a value beyond the range of the type decoded to
+type ErrOverflow struct{ error }
+
+// Error returns the error message
+func (e ErrOverflow) Error() string {
+	return errorString(e.error, "value overflows")
+}
+
+// Is reports whether target is an ErrOverflow, for errors.Is
+func (ErrOverflow) Is(target error) bool {
+	_, ok := target.(ErrOverflow)
+	return ok
+}
+
+// ErrInvalidLength reports input of a length which can't be decoded
+type ErrInvalidLength struct{ error }
+
+// Error returns the error message
+func (e ErrInvalidLength) Error() string {
+	return errorString(e.error, "invalid length")
+}
+
+// Is reports whether target is an ErrInvalidLength, for errors.Is
+func (ErrInvalidLength) Is(target error) bool {
+	_, ok := target.(ErrInvalidLength)
+	return ok
+}
+
+// ErrEmptyInput reports empty input where a value is required. It is also
+// an ErrInvalidLength, which errors.Is will match
+type ErrEmptyInput struct{ error }
+
+// Error returns the error message
+func (e ErrEmptyInput) Error() string {
+	return errorString(e.error, "empty input")
+}
+
+// Is reports whether target is an ErrEmptyInput or ErrInvalidLength, for
+// errors.Is
+func (ErrEmptyInput) Is(target error) bool {
+	switch target.(type) {
+	case ErrEmptyInput, ErrInvalidLength:
+		return true
+	}
+	return false
+}
+
+// ErrInvalidConfig reports an invalid alphabet, option or configuration
+type ErrInvalidConfig struct{ error }
+
+// Error returns the error message
+func (e ErrInvalidConfig) Error() string {
+	return errorString(e.error, "invalid configuration")
+}
+
+// Is reports whether target is an ErrInvalidConfig, for errors.Is
+func (ErrInvalidConfig) Is(target error) bool {
+	_, ok := target.(ErrInvalidConfig)
+	return ok
+}
+
+// ErrSelfTest reports an encoding which failed its self test
+type ErrSelfTest struct{ error }
+
+// Error returns the error message
+func (e ErrSelfTest) Error() string {
+	return errorString(e.error, "self test failed")
+}
+
+// Is reports whether target is an ErrSelfTest, for errors.Is
+func (ErrSelfTest) Is(target error) bool {
+	_, ok := target.(ErrSelfTest)
+	return ok
+}
+
+// Unwrap returns the error decoding a test value, if that failed
+func (e ErrSelfTest) Unwrap() error {
+	return errors.Unwrap(e.error)
+}
+
+// ErrPathMismatch reports a path which doesn't match its template
+type ErrPathMismatch struct{ error }
+
+// Error returns the error message
+func (e ErrPathMismatch) Error() string {
+	return errorString(e.error, "path does not match template")
+}
+
+// Is reports whether target is an ErrPathMismatch, for errors.Is
+func (ErrPathMismatch) Is(target error) bool {
+	_, ok := target.(ErrPathMismatch)
+	return ok
+}
+
+// ErrTransform reports bytes which a Transform could not reverse
+type ErrTransform struct{ error }
+
+// Error returns the error message
+func (e ErrTransform) Error() string {
+	return errorString(e.error, "transform failed")
+}
+
+// Is reports whether target is an ErrTransform, for errors.Is
+func (ErrTransform) Is(target error) bool {
+	_, ok := target.(ErrTransform)
+	return ok
+}
+
+// ErrTooLong reports input exceeding the MaxDecodeLen
+type ErrTooLong struct{ error }
+
+// Error returns the error message
+func (e ErrTooLong) Error() string {
+	return errorString(e.error, "input too long")
+}
+
+// Is reports whether target is an ErrTooLong, for errors.Is
+func (ErrTooLong) Is(target error) bool {
+	_, ok := target.(ErrTooLong)
+	return ok
+}
+
+// ErrNotCanonical reports input rejected by Strict decoding
+type ErrNotCanonical struct{ error }
+
+// Error returns the error message
+func (e ErrNotCanonical) Error() string {
+	return errorString(e.error, "encoding is not canonical")
+}
+
+// Is reports whether target is an ErrNotCanonical, for errors.Is
+func (ErrNotCanonical) Is(target error) bool {
+	_, ok := target.(ErrNotCanonical)
+	return ok
+}
+
+// ErrChecksum reports input with an incorrect check character
+type ErrChecksum struct{ error }
+
+// Error returns the error message
+func (e ErrChecksum) Error() string {
+	return errorString(e.error, "incorrect check character")
+}
+
+// Is reports whether target is an ErrChecksum, for errors.Is
+func (ErrChecksum) Is(target error) bool {
+	_, ok := target.(ErrChecksum)
+	return ok
+}
+
+// ErrInvalidValue reports a value which can't be encoded, or input which
+// can't be decoded or scanned into the type requested
+type ErrInvalidValue struct{ error }
+
+// Error returns the error message
+func (e ErrInvalidValue) Error() string {
+	return errorString(e.error, "invalid value")
+}
+
+// Is reports whether target is an ErrInvalidValue, for errors.Is
+func (ErrInvalidValue) Is(target error) bool {
+	_, ok := target.(ErrInvalidValue)
+	return ok
+}
@@ -0,0 +1,90 @@
+package base62
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorsIs(t *testing.T) {
+	_, overflow := NewStdEncoding().Option(ZigZag()).DecodeToInt64("zzzzzzzzzzzz")
+	_, tooLong := NewStdEncoding().Option(MaxDecodeLen(2)).DecodeToInt64("5Frvgk")
+	_, _, empty := DecodePrefixToInt64("")
+	_, batch := DecodeToInt64Batch([]string{"5Frvgk", "5Frv-gk"})
+
+	testCases := []struct {
+		err     error
+		target  error
+		matches bool
+	}{
+		{StdEncoding.Validate("5Frv-gk"), ErrInvalidCharacter{}, true},
+		{StdEncoding.Validate("5Frv-gk"), ErrOverflow{}, false},
+		{overflow, ErrOverflow{}, true},
+		{overflow, ErrInvalidCharacter{}, false},
+		{tooLong, ErrTooLong{}, true},
+		{empty, ErrEmptyInput{}, true},
+		{empty, ErrInvalidLength{}, true},
+		{ErrInvalidLength{errors.New("Odd length")}, ErrEmptyInput{}, false},
+		{batch, ErrInvalidCharacter{}, true},
+		{fmt.Errorf("Loading ID: %w", overflow), ErrOverflow{}, true},
+		{ErrSelfTest{fmt.Errorf("Self test failed: %w", overflow)}, ErrOverflow{}, true},
+		{ErrSelfTest{fmt.Errorf("Self test failed: %w", overflow)}, ErrSelfTest{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("Matching %v against %T", tc.err, tc.target)
+		assert.Equal(t, tc.matches, errors.Is(tc.err, tc.target))
+	}
+}
+
+func TestErrorsZeroValue(t *testing.T) {
+	for _, err := range []error{
+		ErrInvalidCharacter{},
+		ErrOverflow{},
+		ErrInvalidLength{},
+		ErrEmptyInput{},
+		ErrInvalidConfig{},
+		ErrSelfTest{},
+		ErrPathMismatch{},
+		ErrTransform{},
+		ErrTooLong{},
+		ErrNotCanonical{},
+		ErrChecksum{},
+		ErrInvalidValue{},
+		ErrBatchElement{},
+	} {
+		t.Logf("Formatted %T as %q", err, err.Error())
+		assert.Equal(t, "base62: ", err.Error()[:8])
+		assert.Equal(t, true, errors.Is(err, err))
+	}
+
+	assert.Equal(t, "base62: value overflows", fmt.Sprint(ErrOverflow{}))
+	assert.Equal(t, nil, errors.Unwrap(ErrSelfTest{}))
+}
+
+func TestErrorsEmptyInput(t *testing.T) {
+	_, err := DecodeToInt64("")
+	assert.ErrorIs(t, err, ErrEmptyInput{})
+
+	_, err = DecodeToUint64("")
+	assert.ErrorIs(t, err, ErrEmptyInput{})
+
+	assert.ErrorIs(t, Validate(""), ErrEmptyInput{})
+	assert.ErrorIs(t, Validate(""), ErrInvalidLength{})
+
+	n, err := NewStdEncoding().Option(EmptyZero()).DecodeToInt64("")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+}
+
+func TestErrorsAs(t *testing.T) {
+	err := fmt.Errorf("Loading ID: %w", StdEncoding.Validate("5Frv-gk"))
+
+	var invalid ErrInvalidCharacter
+	if assert.True(t, errors.As(err, &invalid)) {
+		assert.Equal(t, '-', invalid.Char)
+		assert.Equal(t, 4, invalid.Offset)
+	}
+}
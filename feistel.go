@@ -0,0 +1,91 @@
+package base62
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"math"
+)
+
+// feistelRounds is the number of rounds of the Feistel network
+const feistelRounds = 8
+
+// Feistel is a keyed Permutation of the non-negative int64 values, built
+// from a balanced Feistel network over 64 bits. Outputs above the int64
+// range are fed back through the network until they fall within it, so
+// every value maps to another non-negative value. Sequential IDs become
+// unrelated looking values, though this is obfuscation rather than
+// encryption and the key should not be relied upon to keep IDs secret
+type Feistel struct {
+	keys [feistelRounds]uint64
+}
+
+var _ Permutation = (*Feistel)(nil)
+
+// NewFeistel returns a Feistel permutation keyed by key, from which the
+// round keys are derived. Values only unpermute with the same key
+func NewFeistel(key []byte) *Feistel {
+	sum := sha512.Sum512(key)
+
+	var f Feistel
+	for i := range f.keys {
+		f.keys[i] = binary.BigEndian.Uint64(sum[i*8:])
+	}
+	return &f
+}
+
+// Permute maps n to its permuted value, leaving negative values unchanged
+func (f *Feistel) Permute(n int64) int64 {
+	if n < 0 {
+		return n
+	}
+
+	// Cycle walk until back within the int64 range, which terminates as
+	// the cycle containing n returns to it
+	x := uint64(n)
+	for {
+		if x = f.encrypt(x); x <= math.MaxInt64 {
+			return int64(x)
+		}
+	}
+}
+
+// Unpermute reverses Permute
+func (f *Feistel) Unpermute(n int64) int64 {
+	if n < 0 {
+		return n
+	}
+
+	x := uint64(n)
+	for {
+		if x = f.decrypt(x); x <= math.MaxInt64 {
+			return int64(x)
+		}
+	}
+}
+
+// encrypt runs x forwards through the network
+func (f *Feistel) encrypt(x uint64) uint64 {
+	l, r := uint32(x>>32), uint32(x)
+	for _, k := range f.keys {
+		l, r = r, l^feistelRound(r, k)
+	}
+	return uint64(l)<<32 | uint64(r)
+}
+
+// decrypt runs x backwards through the network
+func (f *Feistel) decrypt(x uint64) uint64 {
+	l, r := uint32(x>>32), uint32(x)
+	for i := len(f.keys) - 1; i >= 0; i-- {
+		l, r = r^feistelRound(l, f.keys[i]), l
+	}
+	return uint64(l)<<32 | uint64(r)
+}
+
+// feistelRound is the round function, mixing half a block with the round
+// key using the splitmix64 finaliser
+func feistelRound(half uint32, key uint64) uint32 {
+	z := uint64(half) ^ key
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+	return uint32((z ^ z>>31) >> 32)
+}
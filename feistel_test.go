@@ -0,0 +1,67 @@
+package base62
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeistel(t *testing.T) {
+	f := NewFeistel([]byte("secret"))
+
+	testCases := []struct {
+		n        int64
+		permuted int64
+	}{
+		{0, 1447395818283971377},
+		{1, 3220036081321138791},
+		{2, 1546313889098409499},
+		{math.MaxInt64, 2694061533129263923},
+		{-1, -1},
+	}
+
+	for _, tc := range testCases {
+		p := f.Permute(tc.n)
+		t.Logf("Permuted %d to %d", tc.n, p)
+		assert.Equal(t, tc.permuted, p)
+		assert.Equal(t, tc.n, f.Unpermute(p))
+	}
+}
+
+func TestFeistelRoundTrip(t *testing.T) {
+	f := NewFeistel([]byte("secret"))
+
+	seen := map[int64]bool{}
+	for n := int64(0); n < 10000; n++ {
+		p := f.Permute(n)
+		require.GreaterOrEqual(t, p, int64(0))
+		require.False(t, seen[p], "Permuted %d to a duplicate %d", n, p)
+		seen[p] = true
+
+		require.Equal(t, n, f.Unpermute(p))
+	}
+}
+
+func TestFeistelKey(t *testing.T) {
+	a, b := NewFeistel([]byte("a")), NewFeistel([]byte("b"))
+	assert.NotEqual(t, a.Permute(1), b.Permute(1))
+	assert.NotEqual(t, int64(1), a.Unpermute(b.Permute(1)))
+}
+
+func TestFeistelEncoding(t *testing.T) {
+	e := NewStdEncoding().Option(Permute(NewFeistel([]byte("secret"))))
+
+	prev := ""
+	for n := int64(1); n <= 5; n++ {
+		s := e.EncodeInt64(n)
+		t.Logf("Encoded %d as %s", n, s)
+		assert.NotEqual(t, prev, s)
+		prev = s
+
+		v, err := e.DecodeToInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, n, v)
+	}
+}
@@ -0,0 +1,18 @@
+package base62
+
+import (
+	"flag"
+)
+
+var _ flag.Value = (*Int62)(nil)
+
+// Set implements flag.Value, so that command line flags such as -id 5Frvgk
+// parse to an Int62, with flag.Var
+func (n *Int62) Set(s string) error {
+	return n.UnmarshalText([]byte(s))
+}
+
+// Type names the value in usage messages, as used by pflag
+func (*Int62) Type() string {
+	return "base62"
+}
@@ -0,0 +1,32 @@
+package base62
+
+import (
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt62Flag(t *testing.T) {
+	var (
+		id Int62 = 62
+		fs       = flag.NewFlagSet("test", flag.ContinueOnError)
+	)
+	fs.SetOutput(io.Discard)
+	fs.Var(&id, "id", "record `ID`")
+
+	f := fs.Lookup("id")
+	assert.Equal(t, "10", f.DefValue)
+
+	require.NoError(t, fs.Parse([]string{"-id", "5Frvgk"}))
+	assert.Equal(t, Int62(4815162342), id)
+	assert.Equal(t, "5Frvgk", f.Value.String())
+
+	err := fs.Parse([]string{"-id", "5Frv-gk"})
+	t.Logf("Parsing an invalid flag failed with %v", err)
+	assert.ErrorContains(t, err, `invalid value "5Frv-gk" for flag -id`)
+
+	assert.Equal(t, "base62", id.Type())
+}
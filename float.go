@@ -0,0 +1,55 @@
+package base62
+
+import (
+	"fmt"
+	"math"
+)
+
+// EncodeFloat64 returns the order preserving base62 encoding of f using
+// the StdEncoding, see Encoding.EncodeFloat64
+func EncodeFloat64(f float64) string {
+	return StdEncoding.EncodeFloat64(f)
+}
+
+// DecodeToFloat64 decodes a float encoded by EncodeFloat64 using the
+// StdEncoding
+func DecodeToFloat64(s string) (float64, error) {
+	return StdEncoding.DecodeToFloat64(s)
+}
+
+// EncodeFloat64 returns a fixed width 11 character encoding of f which, with
+// an alphabet in ascending byte order such as the standard alphabet, sorts
+// lexicographically in numeric order, so scores can be used directly as
+// sorted keys. The sign bit of positive values is flipped and every bit of
+// negative values inverted, so -0 sorts immediately before 0 and NaNs sort
+// beyond the infinities
+func (e *Encoding) EncodeFloat64(f float64) string {
+	b := math.Float64bits(f)
+	if b>>63 == 1 {
+		b = ^b
+	} else {
+		b |= 1 << 63
+	}
+
+	return e.pad(e.encodeUint64(b), MaxLenInt64)
+}
+
+// DecodeToFloat64 decodes a float encoded by EncodeFloat64
+func (e *Encoding) DecodeToFloat64(s string) (float64, error) {
+	if len(s) != MaxLenInt64 {
+		return 0, ErrInvalidLength{fmt.Errorf("Encoded float must be %d characters, got %d", MaxLenInt64, len(s))}
+	}
+
+	b, err := e.decodeUint64(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if b>>63 == 1 {
+		b &^= 1 << 63
+	} else {
+		b = ^b
+	}
+
+	return math.Float64frombits(b), nil
+}
@@ -0,0 +1,61 @@
+package base62
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var floatTestcases = []float64{
+	math.Inf(-1),
+	-math.MaxFloat64,
+	-1e10,
+	-1.5,
+	-1,
+	-math.SmallestNonzeroFloat64,
+	math.Copysign(0, -1),
+	0,
+	math.SmallestNonzeroFloat64,
+	0.1,
+	1,
+	1.5,
+	math.Pi,
+	1e10,
+	math.MaxFloat64,
+	math.Inf(1),
+}
+
+func TestEncodeFloat64(t *testing.T) {
+	for _, f := range floatTestcases {
+		s := EncodeFloat64(f)
+		t.Logf("Encoded %v as %s", f, s)
+		assert.Len(t, s, MaxLenInt64)
+
+		v, err := DecodeToFloat64(s)
+		require.NoError(t, err)
+		assert.Equal(t, math.Float64bits(f), math.Float64bits(v))
+	}
+
+	v, err := DecodeToFloat64(EncodeFloat64(math.NaN()))
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(v))
+}
+
+func TestEncodeFloat64Sorts(t *testing.T) {
+	for i := 1; i < len(floatTestcases); i++ {
+		assert.Less(t, EncodeFloat64(floatTestcases[i-1]), EncodeFloat64(floatTestcases[i]))
+	}
+}
+
+func TestDecodeToFloat64Invalid(t *testing.T) {
+	_, err := DecodeToFloat64("0")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeToFloat64("zzzzzzzzzzz")
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = DecodeToFloat64("00000-00000")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
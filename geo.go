@@ -0,0 +1,111 @@
+package base62
+
+import (
+	"fmt"
+	"math"
+)
+
+// GeoMaxPrecision is the number of characters of a full precision encoded
+// coordinate, resolving positions to within a few millimetres
+const GeoMaxPrecision = MaxLenInt64
+
+// EncodeGeo returns the base62 encoding of a coordinate using the
+// StdEncoding, see Encoding.EncodeGeo
+func EncodeGeo(lat, lon float64, precision int) (string, error) {
+	return StdEncoding.EncodeGeo(lat, lon, precision)
+}
+
+// DecodeGeo decodes a coordinate encoded by EncodeGeo using the
+// StdEncoding
+func DecodeGeo(s string) (float64, float64, error) {
+	return StdEncoding.DecodeGeo(s)
+}
+
+// EncodeGeo returns a base62 analogue of a geohash for a latitude and
+// longitude, of precision characters from 1 to GeoMaxPrecision. Each
+// coordinate is quantised to 32 bits and their bits interleaved into a
+// Z-order curve, which is encoded at fixed width and truncated, so longer
+// shared prefixes imply proximity. Each character refines the position by
+// roughly three bits in each axis
+func (e *Encoding) EncodeGeo(lat, lon float64, precision int) (string, error) {
+	if !(lat >= -90 && lat <= 90) || !(lon >= -180 && lon <= 180) {
+		return "", ErrOverflow{fmt.Errorf("Coordinate %v,%v is out of range", lat, lon)}
+	}
+	if precision < 1 || precision > GeoMaxPrecision {
+		return "", ErrInvalidLength{fmt.Errorf("Precision must be from 1 to %d, got %d", GeoMaxPrecision, precision)}
+	}
+
+	z := interleave(quantise(lon, 180), quantise(lat, 90))
+	return e.pad(e.encodeUint64(z), GeoMaxPrecision)[:precision], nil
+}
+
+// DecodeGeo decodes a coordinate encoded by EncodeGeo, returning the
+// latitude and longitude of the middle of the range of positions sharing
+// the encoded prefix
+func (e *Encoding) DecodeGeo(s string) (float64, float64, error) {
+	if len(s) < 1 || len(s) > GeoMaxPrecision {
+		return 0, 0, ErrInvalidLength{fmt.Errorf("Encoded coordinate must be from 1 to %d characters, got %d", GeoMaxPrecision, len(s))}
+	}
+
+	z, err := e.decodeUint64(s)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Scale up to full precision, offset to the middle of the range
+	// covered by the truncated digits
+	span := uint64(1)
+	for i := len(s); i < GeoMaxPrecision; i++ {
+		span *= base
+	}
+	if z > (math.MaxUint64-span/2)/span {
+		return 0, 0, ErrOverflow{fmt.Errorf("Encoded coordinate %s is out of range", s)}
+	}
+	z = z*span + span/2
+
+	lon, lat := deinterleave(z)
+	return dequantise(lat, 90), dequantise(lon, 180), nil
+}
+
+// quantise maps v in [-limit, limit] to the full range of a uint32
+func quantise(v, limit float64) uint32 {
+	return uint32(min((v+limit)/(2*limit)*(1<<32), math.MaxUint32))
+}
+
+// dequantise maps q back to the middle of its range in [-limit, limit]
+func dequantise(q uint32, limit float64) float64 {
+	return (float64(q)+0.5)/(1<<32)*(2*limit) - limit
+}
+
+// interleave returns the bits of x and y interleaved, with x taking the
+// most significant bit
+func interleave(x, y uint32) uint64 {
+	return spread(x)<<1 | spread(y)
+}
+
+// deinterleave reverses interleave
+func deinterleave(z uint64) (uint32, uint32) {
+	return compact(z >> 1), compact(z)
+}
+
+// spread moves each bit of v to twice its position
+func spread(v uint32) uint64 {
+	x := uint64(v)
+	x = (x | x<<16) & 0x0000ffff0000ffff
+	x = (x | x<<8) & 0x00ff00ff00ff00ff
+	x = (x | x<<4) & 0x0f0f0f0f0f0f0f0f
+	x = (x | x<<2) & 0x3333333333333333
+	x = (x | x<<1) & 0x5555555555555555
+	return x
+}
+
+// compact reverses spread, gathering the even bits of x
+func compact(x uint64) uint32 {
+	x &= 0x5555555555555555
+	x = (x | x>>1) & 0x3333333333333333
+	x = (x | x>>2) & 0x0f0f0f0f0f0f0f0f
+	x = (x | x>>4) & 0x00ff00ff00ff00ff
+	x = (x | x>>8) & 0x0000ffff0000ffff
+	x = (x | x>>16) & 0x00000000ffffffff
+	return uint32(x)
+}
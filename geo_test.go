@@ -0,0 +1,96 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeGeo(t *testing.T) {
+	testcases := []struct {
+		lat, lon float64
+	}{
+		{0, 0},
+		{51.5007, -0.1246},
+		{-33.8568, 151.2153},
+		{90, 180},
+		{-90, -180},
+	}
+
+	for _, tc := range testcases {
+		s, err := EncodeGeo(tc.lat, tc.lon, GeoMaxPrecision)
+		require.NoError(t, err)
+		t.Logf("Encoded %v,%v as %s", tc.lat, tc.lon, s)
+		assert.Len(t, s, GeoMaxPrecision)
+
+		lat, lon, err := DecodeGeo(s)
+		require.NoError(t, err)
+		assert.InDelta(t, tc.lat, lat, 1e-7)
+		assert.InDelta(t, tc.lon, lon, 1e-7)
+	}
+}
+
+func TestEncodeGeoPrecision(t *testing.T) {
+	full, err := EncodeGeo(51.5007, -0.1246, GeoMaxPrecision)
+	require.NoError(t, err)
+
+	for p := 1; p <= GeoMaxPrecision; p++ {
+		s, err := EncodeGeo(51.5007, -0.1246, p)
+		require.NoError(t, err)
+		assert.Equal(t, full[:p], s)
+	}
+
+	// Lower precision decodes to within a coarser cell
+	lat, lon, err := DecodeGeo(full[:6])
+	require.NoError(t, err)
+	assert.InDelta(t, 51.5007, lat, 1)
+	assert.InDelta(t, -0.1246, lon, 1)
+}
+
+func TestEncodeGeoProximity(t *testing.T) {
+	a, err := EncodeGeo(51.5007, -0.1246, GeoMaxPrecision)
+	require.NoError(t, err)
+	near, err := EncodeGeo(51.5014, -0.1419, GeoMaxPrecision)
+	require.NoError(t, err)
+	far, err := EncodeGeo(-33.8568, 151.2153, GeoMaxPrecision)
+	require.NoError(t, err)
+
+	assert.Greater(t, commonPrefix(a, near), commonPrefix(a, far))
+}
+
+func TestEncodeGeoInvalid(t *testing.T) {
+	_, err := EncodeGeo(91, 0, 6)
+	assert.IsType(t, ErrOverflow{}, err)
+	_, err = EncodeGeo(0, -181, 6)
+	assert.IsType(t, ErrOverflow{}, err)
+	_, err = EncodeGeo(0, 0, 0)
+	assert.IsType(t, ErrInvalidLength{}, err)
+	_, err = EncodeGeo(0, 0, GeoMaxPrecision+1)
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, _, err = DecodeGeo("")
+	assert.IsType(t, ErrInvalidLength{}, err)
+	_, _, err = DecodeGeo("zzzz")
+	assert.IsType(t, ErrOverflow{}, err)
+	_, _, err = DecodeGeo("00-0")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func commonPrefix(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+func TestInterleave(t *testing.T) {
+	assert.Equal(t, uint64(0b10), interleave(1, 0))
+	assert.Equal(t, uint64(0b01), interleave(0, 1))
+	assert.Equal(t, uint64(0xffffffffffffffff), interleave(0xffffffff, 0xffffffff))
+
+	x, y := deinterleave(interleave(0xdeadbeef, 0x12345678))
+	assert.Equal(t, uint32(0xdeadbeef), x)
+	assert.Equal(t, uint32(0x12345678), y)
+}
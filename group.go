@@ -0,0 +1,101 @@
+package base62
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Group sets encoded integers to be formatted for display by inserting sep
+// between every n characters, such as ABCD-EFGH-IJKL. Decoding with the
+// encoding removes sep again before decoding
+func Group(n int, sep string) option {
+	return func(e *Encoding) {
+		e.groupSize = n
+		e.groupSep = sep
+	}
+}
+
+// IgnoreSeparators sets any of the characters of seps to be removed from
+// encoded strings before decoding, so codes copied from formatted displays
+// such as ABCD-EFGH or ABCD EFGH decode without normalising them first
+func IgnoreSeparators(seps string) option {
+	return func(e *Encoding) {
+		e.ignore = seps
+	}
+}
+
+// Lenient sets decoding to skip whitespace, including newlines, and hyphens
+// which aren't part of the alphabet, so values copied from emails or
+// formatted displays such as AB3-9xK decode without cleaning them first
+func Lenient() option {
+	return func(e *Encoding) {
+		e.lenient = true
+	}
+}
+
+// Format returns an encoded string formatted with the grouping of the
+// encoding, or unchanged if the encoding is not grouped
+func (e *Encoding) Format(s string) string {
+	return e.format(s)
+}
+
+// FormattedLen returns the length of an encoded string of n characters
+// once formatted with the grouping of the encoding
+func (e *Encoding) FormattedLen(n int) int {
+	if e.groupSize <= 0 || n == 0 {
+		return n
+	}
+
+	return n + (n-1)/e.groupSize*len(e.groupSep)
+}
+
+// format inserts the group separator between every group of characters
+func (e *Encoding) format(s string) string {
+	if e.groupSize <= 0 || len(s) <= e.groupSize {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(e.FormattedLen(len(s)))
+	for i := 0; i < len(s); i += e.groupSize {
+		if i > 0 {
+			b.WriteString(e.groupSep)
+		}
+		b.WriteString(s[i:min(i+e.groupSize, len(s))])
+	}
+
+	return b.String()
+}
+
+// unformat removes the group separator, ignored separator characters,
+// lenient whitespace and padding characters from a formatted string
+func (e *Encoding) unformat(s string) string {
+	if e.groupSize > 0 && e.groupSep != "" {
+		s = strings.ReplaceAll(s, e.groupSep, "")
+	}
+	if e.ignore != "" && strings.ContainsAny(s, e.ignore) {
+		s = strings.Map(func(r rune) rune {
+			if strings.ContainsRune(e.ignore, r) {
+				return -1
+			}
+			return r
+		}, s)
+	}
+	if e.lenient {
+		// Keep a leading sign marker, so negative values aren't made positive
+		var sign string
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		if len(s) > 0 && s[0] == e.marker() {
+			sign, s = s[:1], s[1:]
+		}
+
+		s = sign + strings.Map(func(r rune) rune {
+			if unicode.IsSpace(r) || (r == '-' && e.index('-') == -1) {
+				return -1
+			}
+			return r
+		}, s)
+	}
+
+	return e.unpad(s)
+}
@@ -0,0 +1,104 @@
+package base62
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroup(t *testing.T) {
+	testCases := []struct {
+		n    int64
+		opts []option
+		out  string
+	}{
+		{0, []option{Group(4, "-")}, "0"},
+		{61, []option{Group(4, "-")}, "z"},
+		{4815162342, []option{Group(4, "-")}, "5Frv-gk"},
+		{4815162342, []option{Group(3, " ")}, "5Fr vgk"},
+		{math.MaxInt64, []option{Group(4, "-")}, "AzL8-n0Y5-8m7"},
+		{4815162342, []option{Padding(12), Group(4, "-")}, "0000-005F-rvgk"},
+		{4815162342, []option{Group(0, "-")}, "5Frvgk"},
+	}
+
+	for _, tc := range testCases {
+		e := NewStdEncoding().Option(tc.opts...)
+		s := e.EncodeInt64(tc.n)
+		t.Logf("Encoded %d as %q with %v", tc.n, s, e)
+		assert.Equal(t, tc.out, s)
+		assert.Equal(t, len(s), e.FormattedLen(len(e.unformat(s))))
+
+		// Grouped values decode with the separators in place
+		v, err := e.DecodeToInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, tc.n, v)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	e := NewStdEncoding().Option(Group(4, "-"))
+	assert.Equal(t, "ABCD-EFGH-IJKL", e.Format("ABCDEFGHIJKL"))
+	assert.Equal(t, "ABCDEFGHIJKL", StdEncoding.Format("ABCDEFGHIJKL"))
+}
+
+func TestFormattedLen(t *testing.T) {
+	e := NewStdEncoding().Option(Group(4, "--"))
+	for n, l := range []int{0, 1, 2, 3, 4, 7, 8, 9, 10, 13} {
+		assert.Equal(t, l, e.FormattedLen(n))
+		assert.Equal(t, n, StdEncoding.FormattedLen(n))
+	}
+}
+
+func TestIgnoreSeparators(t *testing.T) {
+	e := NewStdEncoding().Option(IgnoreSeparators("- "))
+
+	for _, s := range []string{"5Frvgk", "5Frv-gk", "5F rv gk", " 5Frv-gk ", "-5-F-r-v-g-k-"} {
+		v, err := e.DecodeToInt64(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, int64(4815162342), v)
+
+		v, ok := e.TryDecodeToInt64(s)
+		assert.True(t, ok, s)
+		assert.Equal(t, int64(4815162342), v)
+	}
+
+	// Separators which are not ignored remain invalid
+	_, err := e.DecodeToInt64("5Frv_gk")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+	_, err = StdEncoding.DecodeToInt64("5Frv-gk")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestLenient(t *testing.T) {
+	e := NewStdEncoding().Option(Lenient())
+
+	for _, s := range []string{"5Frvgk", "5Frv-gk", "5F rv gk", " 5Frv-gk\n", "5Frv\r\n-gk", "\t5Frv gk "} {
+		v, err := e.DecodeToInt64(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, int64(4815162342), v)
+	}
+
+	_, err := e.DecodeToInt64("5Frv_gk")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+
+	// A leading sign marker is kept
+	signed := NewStdEncoding().Option(Lenient(), Sign('-'))
+	v, err := signed.DecodeToInt64(" -5Frv-gk")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-4815162342), v)
+
+	v, err = e.DecodeToInt64("-5Frvgk")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-4815162342), v)
+
+	_, err = e.DecodeToInt64("~5Frvgk")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+
+	// Hyphens in the alphabet are digits
+	dash := NewEncoding("-123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz", Sign('~'), Lenient())
+	v, err = dash.DecodeToInt64("1-")
+	require.NoError(t, err)
+	assert.Equal(t, int64(62), v)
+}
@@ -0,0 +1,142 @@
+package base62
+
+import (
+	"fmt"
+	"math"
+)
+
+// Hasher encodes lists of non-negative integers into single opaque tokens,
+// in the spirit of Hashids. The alphabet is shuffled by a salt, and again
+// for each token by a lottery character derived from the values, so that
+// sequential values produce unrelated looking tokens. This obscures values
+// but is not encryption
+type Hasher struct {
+	e        *Encoding
+	alphabet string
+	salt     string
+}
+
+// NewHasher returns a Hasher for the salt using the StdEncoding
+func NewHasher(salt string) *Hasher {
+	return StdEncoding.NewHasher(salt)
+}
+
+// NewHasher returns a Hasher for the salt using the alphabet of the
+// encoding. Tokens only decode with the same alphabet and salt
+func (e *Encoding) NewHasher(salt string) *Hasher {
+	return &Hasher{
+		e:        e,
+		alphabet: shuffle(e.encode, salt),
+		salt:     salt,
+	}
+}
+
+// Encode returns the token of the values, such as a (shard, id) pair. The
+// token is a lottery character followed by each value prefixed with its
+// length, all in the shuffled alphabet
+func (h *Hasher) Encode(nums ...uint64) string {
+	if len(nums) == 0 {
+		return ""
+	}
+
+	// The lottery depends on every value, so that similar lists differ
+	var sum uint64
+	for i, n := range nums {
+		sum += n % uint64(i+100)
+	}
+	lottery := h.alphabet[sum%base]
+
+	var (
+		alphabet = shuffle(h.alphabet, string(lottery)+h.salt)
+		out      = []byte{lottery}
+		buf      [MaxLenInt64 + 1]byte
+	)
+	for _, n := range nums {
+		// Fill digits from the least significant upwards, then the length
+		i := len(buf)
+		for {
+			i--
+			buf[i] = alphabet[n%base]
+			if n /= base; n == 0 {
+				break
+			}
+		}
+		i--
+		buf[i] = alphabet[len(buf)-i-1]
+
+		out = append(out, buf[i:]...)
+	}
+
+	return string(out)
+}
+
+// Decode returns the values of a token returned by Encode. As any string
+// of the alphabet might otherwise decode, the values are verified by
+// encoding them again, returning an ErrChecksum if they don't match
+func (h *Hasher) Decode(s string) ([]uint64, error) {
+	if len(s) < 3 {
+		return nil, ErrInvalidLength{fmt.Errorf("Token %s is too short to hold any values", s)}
+	}
+
+	alphabet := shuffle(h.alphabet, s[:1]+h.salt)
+
+	var index [256]int
+	for i := range index {
+		index[i] = -1
+	}
+	for i := 0; i < len(alphabet); i++ {
+		index[alphabet[i]] = i
+	}
+
+	var nums []uint64
+	for i := 1; i < len(s); {
+		l := index[s[i]]
+		switch {
+		case l == -1:
+			return nil, h.e.invalidCharacter(s, i)
+		case l == 0 || l > MaxLenInt64 || i+l >= len(s):
+			return nil, ErrInvalidLength{fmt.Errorf("Token %s has an invalid length at %d", s, i)}
+		}
+
+		var n uint64
+		for j := i + 1; j <= i+l; j++ {
+			d := index[s[j]]
+			if d == -1 {
+				return nil, h.e.invalidCharacter(s, j)
+			}
+			if n > (math.MaxUint64-uint64(d))/base {
+				return nil, ErrOverflow{fmt.Errorf("Value of token %s overflows 64 bits", s)}
+			}
+			n = n*base + uint64(d)
+		}
+
+		nums = append(nums, n)
+		i += l + 1
+	}
+
+	if h.Encode(nums...) != s {
+		return nil, ErrChecksum{fmt.Errorf("Token %s does not verify", s)}
+	}
+
+	return nums, nil
+}
+
+// shuffle returns the alphabet deterministically shuffled by the salt, as
+// with the consistent shuffle of Hashids
+func shuffle(alphabet, salt string) string {
+	if salt == "" {
+		return alphabet
+	}
+
+	b := []byte(alphabet)
+	for i, v, p := len(b)-1, 0, 0; i > 0; i-- {
+		v %= len(salt)
+		n := int(salt[v])
+		p += n
+		j := (n + v + p) % i
+		b[i], b[j] = b[j], b[i]
+		v++
+	}
+
+	return string(b)
+}
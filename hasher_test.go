@@ -0,0 +1,78 @@
+package base62
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasher(t *testing.T) {
+	h := NewHasher("this is my salt")
+
+	for _, nums := range [][]uint64{
+		{0},
+		{1},
+		{12345},
+		{3, 4815162342},
+		{1, 2, 3, 4, 5},
+		{math.MaxUint64, 0, math.MaxUint64},
+	} {
+		s := h.Encode(nums...)
+		t.Logf("Encoded %v as %s", nums, s)
+
+		v, err := h.Decode(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, nums, v)
+	}
+
+	assert.Equal(t, "", h.Encode())
+}
+
+func TestHasherNonSequential(t *testing.T) {
+	h := NewHasher("salt")
+
+	// Consecutive values don't produce consecutive tokens
+	a, b := h.Encode(7, 100), h.Encode(7, 101)
+	t.Logf("Encoded consecutive values as %s and %s", a, b)
+	assert.NotEqual(t, a[:1], b[:1])
+
+	// Tokens depend on the salt
+	assert.NotEqual(t, a, NewHasher("pepper").Encode(7, 100))
+	_, err := NewHasher("pepper").Decode(a)
+	assert.Error(t, err)
+
+	// And without a salt are still shuffled per token
+	assert.NotEqual(t, EncodeInt64(100), NewHasher("").Encode(100)[2:])
+}
+
+func TestHasherDecodeInvalid(t *testing.T) {
+	h := NewHasher("salt")
+	s := h.Encode(3, 4815162342)
+
+	_, err := h.Decode(s[:2])
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = h.Decode(s[:len(s)-1])
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = h.Decode(s[:3] + "-" + s[4:])
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+
+	// Changing the lottery character changes every value
+	l := "0"
+	if s[0] == '0' {
+		l = "1"
+	}
+	_, err = h.Decode(l + s[1:])
+	assert.Error(t, err)
+}
+
+func TestShuffle(t *testing.T) {
+	s := shuffle(encodeStd, "salt")
+	assert.NotEqual(t, encodeStd, s)
+	assert.Equal(t, s, shuffle(encodeStd, "salt"))
+	assert.NoError(t, validateAlphabet(s))
+	assert.Equal(t, encodeStd, shuffle(encodeStd, ""))
+}
@@ -0,0 +1,52 @@
+package base62
+
+import (
+	"fmt"
+)
+
+// Int62 is an int64 which marshals as its base62 encoding with the
+// StdEncoding, such as "5Frvgk", so struct fields are encoded in JSON, XML
+// and YAML without converting them in every handler
+type Int62 int64
+
+// String returns the base62 encoding of n
+func (n Int62) String() string {
+	return EncodeInt64(int64(n))
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (n Int62) MarshalText() ([]byte, error) {
+	return StdEncoding.AppendInt64(nil, int64(n)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (n *Int62) UnmarshalText(b []byte) error {
+	v, err := DecodeToInt64(string(b))
+	if err != nil {
+		return err
+	}
+
+	*n = Int62(v)
+	return nil
+}
+
+// IsZero returns whether n is zero, for omitzero and omitempty options of
+// encoders and ORMs which check for an IsZero method
+func (n Int62) IsZero() bool {
+	return n == 0
+}
+
+// GormDataType returns the column type of an Int62 for GORM migrations
+func (Int62) GormDataType() string {
+	return "bigint"
+}
+
+// Scan implements fmt.Scanner, reading the base62 encoding by the %v or %s
+// verbs, such as fmt.Sscanf("user 3kTMd2", "user %v", &id)
+func (n *Int62) Scan(state fmt.ScanState, verb rune) error {
+	tok, err := scanToken(state, verb)
+	if err != nil {
+		return err
+	}
+	return n.UnmarshalText([]byte(tok))
+}
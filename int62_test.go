@@ -0,0 +1,90 @@
+package base62
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt62JSON(t *testing.T) {
+	type record struct {
+		ID     Int62   `json:"id"`
+		Parent *Int62  `json:"parent,omitempty"`
+		Refs   []Int62 `json:"refs"`
+	}
+
+	parent := Int62(62)
+	r := record{ID: 4815162342, Parent: &parent, Refs: []Int62{0, 1}}
+
+	b, err := json.Marshal(r)
+	require.NoError(t, err)
+	t.Logf("Marshalled %+v as %s", r, b)
+	assert.Equal(t, `{"id":"5Frvgk","parent":"10","refs":["0","1"]}`, string(b))
+
+	var v record
+	require.NoError(t, json.Unmarshal(b, &v))
+	assert.Equal(t, r, v)
+
+	err = json.Unmarshal([]byte(`{"id":"5Frv-gk"}`), &v)
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestInt62XML(t *testing.T) {
+	type record struct {
+		ID   Int62 `xml:"id,attr"`
+		Name Int62 `xml:"name"`
+	}
+
+	r := record{ID: 4815162342, Name: 1}
+
+	b, err := xml.Marshal(r)
+	require.NoError(t, err)
+	assert.Equal(t, `<record id="5Frvgk"><name>1</name></record>`, string(b))
+
+	var v record
+	require.NoError(t, xml.Unmarshal(b, &v))
+	assert.Equal(t, r, v)
+}
+
+func TestInt62Negative(t *testing.T) {
+	b, err := Int62(-62).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "-10", string(b))
+
+	var n Int62
+	require.NoError(t, n.UnmarshalText(b))
+	assert.Equal(t, Int62(-62), n)
+}
+
+func TestInt62String(t *testing.T) {
+	assert.Equal(t, "5Frvgk", Int62(4815162342).String())
+}
+
+func TestInt62ORM(t *testing.T) {
+	assert.True(t, Int62(0).IsZero())
+	assert.False(t, Int62(1).IsZero())
+	assert.Equal(t, "bigint", Int62(0).GormDataType())
+	assert.Equal(t, "bigint", NullInt62{}.GormDataType())
+}
+
+func TestInt62Scanner(t *testing.T) {
+	var id Int62
+	_, err := fmt.Sscanf("user 3kTMd2", "user %v", &id)
+	require.NoError(t, err)
+	assert.Equal(t, "3kTMd2", id.String())
+
+	// Digits are base62 rather than decimal
+	_, err = fmt.Sscan("62", &id)
+	require.NoError(t, err)
+	assert.Equal(t, Int62(374), id)
+
+	_, err = fmt.Sscanf("user 3kTMd2", "user %d", &id)
+	assert.IsType(t, ErrInvalidValue{}, err)
+
+	_, err = fmt.Sscan("3kTM-d2", &id)
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
@@ -0,0 +1,116 @@
+//go:build go1.23
+
+package base62
+
+import (
+	"bufio"
+	"io"
+	"iter"
+)
+
+/**
+ * Iterators
+ */
+
+// DecodeToInt64Seq returns an iterator which lazily decodes each of the
+// base62 encoded tokens using the StdEncoding
+func DecodeToInt64Seq(tokens []string) iter.Seq2[int64, error] {
+	return StdEncoding.DecodeToInt64Seq(tokens)
+}
+
+// DecodeToInt64Reader returns an iterator which lazily decodes whitespace
+// separated base62 tokens read from r using the StdEncoding
+func DecodeToInt64Reader(r io.Reader) iter.Seq2[int64, error] {
+	return StdEncoding.DecodeToInt64Reader(r)
+}
+
+// DecodeToInt64Seq returns an iterator which lazily decodes each of the
+// base62 encoded tokens. Tokens which fail to decode yield their error,
+// iteration continues until the tokens are exhausted or the consumer stops
+func (e *Encoding) DecodeToInt64Seq(tokens []string) iter.Seq2[int64, error] {
+	return func(yield func(int64, error) bool) {
+		for _, s := range tokens {
+			if !yield(e.DecodeToInt64(s)) {
+				return
+			}
+		}
+	}
+}
+
+// DecodeToInt64Reader returns an iterator which lazily decodes whitespace
+// separated base62 tokens read from r. Tokens which fail to decode yield
+// their error and iteration continues, an error reading from r is yielded
+// and ends the iteration
+func (e *Encoding) DecodeToInt64Reader(r io.Reader) iter.Seq2[int64, error] {
+	return func(yield func(int64, error) bool) {
+		scanner := bufio.NewScanner(r)
+		scanner.Split(bufio.ScanWords)
+
+		for scanner.Scan() {
+			if !yield(e.DecodeToInt64(scanner.Text())) {
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			yield(0, err)
+		}
+	}
+}
+
+// Range returns an iterator over the encodings of every value in
+// [start, end) using the StdEncoding
+func Range(start, end int64) iter.Seq[string] {
+	return StdEncoding.RangeStep(start, end, 1)
+}
+
+// RangeStep returns an iterator over the encodings of the values in
+// [start, end) separated by step using the StdEncoding
+func RangeStep(start, end, step int64) iter.Seq[string] {
+	return StdEncoding.RangeStep(start, end, step)
+}
+
+// Range returns an iterator over the encodings of every value in [start, end)
+func (e *Encoding) Range(start, end int64) iter.Seq[string] {
+	return e.RangeStep(start, end, 1)
+}
+
+// RangeStep returns an iterator over the encodings of the values in
+// [start, end) separated by step, which must be positive. Values from zero
+// upwards are stepped through by adding to the digits of the previous
+// encoding, where the encoding writes values as their plain digits, so long
+// ranges avoid a division per digit. It panics if step is not positive
+func (e *Encoding) RangeStep(start, end, step int64) iter.Seq[string] {
+	if step <= 0 {
+		panic("base62: range step must be positive")
+	}
+
+	return func(yield func(string) bool) {
+		if start >= end {
+			return
+		}
+
+		// Negative values are encoded in full, as their digits count down
+		// while their values count up. The difference to the end is taken
+		// unsigned, as it may exceed math.MaxInt64
+		n := start
+		for ; n < 0 || !e.inStep(); n += step {
+			if !yield(e.EncodeInt64(n)) || uint64(end-n) <= uint64(step) {
+				return
+			}
+		}
+
+		d, _ := e.digits(e.encodeInt64(n))
+		for ; ; n += step {
+			if !yield(e.fromDigits(d)) {
+				return
+			}
+
+			// Stop before stepping past the end, or overflowing
+			if end-n <= step {
+				return
+			}
+			d = addDigits(d, uint64(step)*e.unit())
+		}
+	}
+}
@@ -0,0 +1,169 @@
+//go:build go1.23
+
+package base62
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeToInt64Seq(t *testing.T) {
+	tokens := make([]string, 0, len(testcases))
+	for _, tc := range testcases {
+		tokens = append(tokens, tc.encoded)
+	}
+
+	i := 0
+	for v, err := range DecodeToInt64Seq(tokens) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, testcases[i].num, v)
+		i++
+	}
+	assert.Equal(t, len(testcases), i)
+}
+
+func TestDecodeToInt64SeqInvalid(t *testing.T) {
+	var (
+		values []int64
+		errs   int
+	)
+
+	for v, err := range DecodeToInt64Seq([]string{"1", "1-", "10"}) {
+		if err != nil {
+			assert.IsType(t, ErrInvalidCharacter{}, err)
+			errs++
+			continue
+		}
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []int64{1, 62}, values)
+	assert.Equal(t, 1, errs)
+}
+
+func TestDecodeToInt64SeqBreak(t *testing.T) {
+	var values []int64
+	for v := range DecodeToInt64Seq([]string{"1", "2", "3"}) {
+		values = append(values, v)
+		if len(values) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int64{1, 2}, values)
+}
+
+func TestDecodeToInt64Reader(t *testing.T) {
+	r := strings.NewReader("1 A\n5Frvgk\t\tAzL8n0Y58m7\n")
+
+	var values []int64
+	for v, err := range DecodeToInt64Reader(r) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []int64{1, 10, 4815162342, 9223372036854775807}, values)
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read(p []byte) (int, error) { return 0, r.err }
+
+func TestDecodeToInt64ReaderError(t *testing.T) {
+	readErr := errors.New("read failed")
+
+	var errs []error
+	for _, err := range DecodeToInt64Reader(errReader{readErr}) {
+		errs = append(errs, err)
+	}
+
+	assert.Equal(t, []error{readErr}, errs)
+}
+
+func TestRange(t *testing.T) {
+	var (
+		values []string
+		n      int64 = 55
+	)
+	for s := range Range(55, 130) {
+		assert.Equal(t, EncodeInt64(n), s)
+		values = append(values, s)
+		n++
+	}
+	assert.Len(t, values, 75)
+	assert.Equal(t, "t", values[0])
+	assert.Equal(t, "25", values[len(values)-1])
+}
+
+func TestRangeStep(t *testing.T) {
+	testcases := []struct {
+		start, end, step int64
+		encoded          []string
+	}{
+		{0, 0, 1, nil},
+		{10, 5, 1, nil},
+		{0, 1, 1, []string{"0"}},
+		{60, 64, 1, []string{"y", "z", "10", "11"}},
+		{0, 186, 62, []string{"0", "10", "20"}},
+		{0, 187, 62, []string{"0", "10", "20", "30"}},
+		{9223372036854775805, 9223372036854775807, 1, []string{"AzL8n0Y58m5", "AzL8n0Y58m6"}},
+		{9223372036854775800, 9223372036854775807, 5, []string{"AzL8n0Y58m0", "AzL8n0Y58m5"}},
+		{-3, 2, 1, []string{"-3", "-2", "-1", "0", "1"}},
+		{-63, 63, 62, []string{"-11", "-1", "z"}},
+		{-9223372036854775808, 9223372036854775807, 9223372036854775807, []string{"-AzL8n0Y58m8", "-1", "AzL8n0Y58m6"}},
+	}
+
+	for _, tc := range testcases {
+		var values []string
+		for s := range RangeStep(tc.start, tc.end, tc.step) {
+			values = append(values, s)
+		}
+		assert.Equal(t, tc.encoded, values)
+	}
+}
+
+func TestRangePadded(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(3))
+
+	var values []string
+	for s := range e.RangeStep(0, 3844, 1000) {
+		values = append(values, s)
+	}
+	assert.Equal(t, []string{"000", "0G8", "0WG", "0mO"}, values)
+}
+
+func TestRangeOptions(t *testing.T) {
+	encodings := []*Encoding{
+		NewStdEncoding().Option(WithMask(0x5f3759df)),
+		NewStdEncoding().Option(KnuthHash()),
+		NewStdEncoding().Option(Luhn()),
+		NewStdEncoding().Option(Sortable()),
+		NewStdEncoding().Option(Group(2, "-"), Padding(6)),
+		NewStdEncoding().Option(LetterFirst()),
+		NewStdEncoding().Option(ZigZag(), Padding(3)),
+		NewStdEncoding().Option(Padding(3)),
+	}
+
+	for _, e := range encodings {
+		t.Logf("Encoding %v", e)
+
+		var values, expected []string
+		for s := range e.RangeStep(-58, 4000, 7) {
+			values = append(values, s)
+		}
+		for n := int64(-58); n < 4000; n += 7 {
+			expected = append(expected, e.EncodeInt64(n))
+		}
+		assert.Equal(t, expected, values)
+	}
+}
+
+func TestRangeInvalid(t *testing.T) {
+	assert.Panics(t, func() { RangeStep(0, 10, 0) })
+}
@@ -0,0 +1,43 @@
+package base62
+
+// knuthMultiplier is 2^64 divided by the golden ratio, rounded to odd so
+// that multiplication by it is invertible
+const knuthMultiplier = 0x9e3779b97f4a7c15
+
+// knuthMask limits values to the 63 bits of non-negative int64 values
+const knuthMask = 1<<63 - 1
+
+// knuthInverse is the multiplicative inverse of knuthMultiplier
+var knuthInverse = modInverse(knuthMultiplier)
+
+// knuthPermuter is shared by all encodings, so they remain equal
+var knuthPermuter = &permuter{knuth{}}
+
+// KnuthHash sets int64 values to be spread across the keyspace by a
+// reversible Knuth multiplicative hash before they are encoded, and
+// restored after they are decoded. The product is folded so its low bits
+// depend on the high bits too, so that sequential IDs distribute evenly
+// over shards or partitions by any range of bits. This is not obfuscation,
+// as anyone can reverse the hash
+func KnuthHash() option {
+	return func(e *Encoding) {
+		e.permutation = knuthPermuter
+	}
+}
+
+// knuth is the Permutation of KnuthHash
+type knuth struct{}
+
+// Permute multiplies n by the golden ratio modulo 2^63, then folds the
+// high bits into the low bits
+func (knuth) Permute(n int64) int64 {
+	x := uint64(n) * knuthMultiplier & knuthMask
+	return int64(x ^ x>>31)
+}
+
+// Unpermute reverses Permute
+func (knuth) Unpermute(n int64) int64 {
+	x := uint64(n)
+	x ^= x>>31 ^ x>>62
+	return int64(x * knuthInverse & knuthMask)
+}
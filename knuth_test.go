@@ -0,0 +1,67 @@
+package base62
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKnuthHash(t *testing.T) {
+	testCases := []struct {
+		n      int64
+		hashed int64
+	}{
+		{0, 0},
+		{1, 2177342781459304295},
+		{2, 4354685562918608591},
+		{3, 6532028348658593383},
+		{100, 5596721396652663007},
+		{math.MaxInt64, 7046029253353443174},
+	}
+
+	e := NewStdEncoding().Option(KnuthHash())
+	for _, tc := range testCases {
+		s := e.EncodeInt64(tc.n)
+		t.Logf("Encoded %d as %s", tc.n, s)
+		assert.Equal(t, StdEncoding.EncodeInt64(tc.hashed), s)
+
+		n, err := e.DecodeToInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, tc.n, n)
+	}
+
+	// Negative values are unchanged
+	assert.Equal(t, "-5", e.Option(Sign('-')).EncodeInt64(-5))
+}
+
+func TestKnuthHashReversible(t *testing.T) {
+	var k knuth
+	for n := int64(0); n < 10000; n++ {
+		require.Equal(t, n, k.Unpermute(k.Permute(n)))
+	}
+	for n := int64(math.MaxInt64); n > math.MaxInt64-10000; n-- {
+		require.Equal(t, n, k.Unpermute(k.Permute(n)))
+	}
+}
+
+func TestKnuthHashDistribution(t *testing.T) {
+	// Sequential values spread evenly over shards by their low bits
+	var (
+		k      knuth
+		shards [16]int
+	)
+	for n := int64(0); n < 16000; n++ {
+		shards[k.Permute(n)%16]++
+	}
+	for i, c := range shards {
+		t.Logf("Shard %d has %d values", i, c)
+		assert.InDelta(t, 1000, c, 150)
+	}
+}
+
+func TestKnuthHashEqual(t *testing.T) {
+	assert.True(t, NewStdEncoding().Option(KnuthHash()).Equal(NewStdEncoding().Option(KnuthHash())))
+	assert.False(t, NewStdEncoding().Option(KnuthHash()).Equal(NewStdEncoding()))
+}
@@ -0,0 +1,97 @@
+package base62
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// KSUID is a 160 bit K-sortable identifier, of a 32 bit timestamp in
+// seconds since the KSUID epoch followed by 128 random bits, compatible
+// with the KSUID format. Encoded to a fixed width of 27 characters with the
+// standard alphabet, the string order of KSUIDs is their order of creation
+type KSUID [20]byte
+
+// ksuidEpoch is the Unix time of the zero timestamp of a KSUID
+const ksuidEpoch = 1400000000
+
+// ksuidWidth is the encoded width of a KSUID
+const ksuidWidth = 27
+
+// NewKSUID returns a KSUID for time t, with random bits read from entropy,
+// or crypto/rand if entropy is nil. An ErrOverflow is returned if t is
+// outside of the range of 32 bits of seconds from the KSUID epoch
+func NewKSUID(t time.Time, entropy io.Reader) (KSUID, error) {
+	var k KSUID
+
+	ts := t.Unix() - ksuidEpoch
+	if ts < 0 || ts > 1<<32-1 {
+		return k, ErrOverflow{fmt.Errorf("Time %v is outside of the range of a KSUID", t)}
+	}
+
+	if entropy == nil {
+		entropy = rand.Reader
+	}
+	if _, err := io.ReadFull(entropy, k[4:]); err != nil {
+		return k, err
+	}
+	binary.BigEndian.PutUint32(k[:4], uint32(ts))
+
+	return k, nil
+}
+
+// Timestamp returns the time of the KSUID, to second precision
+func (k KSUID) Timestamp() time.Time {
+	return time.Unix(int64(binary.BigEndian.Uint32(k[:4]))+ksuidEpoch, 0)
+}
+
+// Payload returns the random bits of the KSUID
+func (k KSUID) Payload() []byte {
+	return k[4:]
+}
+
+// EncodeKSUID returns the 27 character encoding of a KSUID using the
+// StdEncoding, see Encoding.EncodeKSUID
+func EncodeKSUID(k KSUID) string {
+	return StdEncoding.EncodeKSUID(k)
+}
+
+// ParseKSUID decodes a KSUID using the StdEncoding, see Encoding.ParseKSUID
+func ParseKSUID(s string) (KSUID, error) {
+	return StdEncoding.ParseKSUID(s)
+}
+
+// EncodeKSUID returns the fixed width 27 character encoding of a KSUID.
+// Other Codecs encode KSUIDs with EncodeArrayWith
+func (e *Encoding) EncodeKSUID(k KSUID) string {
+	var dst [ksuidWidth]byte
+	e.putFixed(dst[:], k[:])
+	return string(dst[:])
+}
+
+// ParseKSUID decodes a KSUID encoded by EncodeKSUID, from which the
+// creation time may be extracted with Timestamp
+func (e *Encoding) ParseKSUID(s string) (KSUID, error) {
+	var k KSUID
+	err := e.decodeFixed(s, k[:])
+	return k, err
+}
+
+// Scan implements fmt.Scanner, reading a KSUID encoded with the StdEncoding
+// by the %v or %s verbs of fmt.Sscanf and the like
+func (k *KSUID) Scan(state fmt.ScanState, verb rune) error {
+	s, err := scanToken(state, verb)
+	if err != nil {
+		return err
+	}
+
+	v, err := ParseKSUID(s)
+	if err != nil {
+		return err
+	}
+
+	*k = v
+	return nil
+}
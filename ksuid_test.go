@@ -0,0 +1,70 @@
+package base62
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKSUID(t *testing.T) {
+	// The example from the KSUID documentation
+	k, err := ParseKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(107608047+ksuidEpoch), k.Timestamp().Unix())
+	assert.Equal(t, "b5a1cd34b5f99d1154fb6853345c9735", hex.EncodeToString(k.Payload()))
+	assert.Equal(t, "0ujtsYcgvSTl8PAuAdqWYSMnLOv", EncodeKSUID(k))
+}
+
+func TestNewKSUID(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	k, err := NewKSUID(now, nil)
+	require.NoError(t, err)
+	assert.True(t, now.Equal(k.Timestamp()))
+
+	s := EncodeKSUID(k)
+	t.Logf("Encoded KSUID %x as %s", k, s)
+	assert.Len(t, s, 27)
+
+	v, err := ParseKSUID(s)
+	require.NoError(t, err)
+	assert.Equal(t, k, v)
+}
+
+func TestKSUIDSortOrder(t *testing.T) {
+	var ids []string
+	for i := 0; i < 100; i++ {
+		k, err := NewKSUID(time.Unix(ksuidEpoch+int64(i)*86413, 0), nil)
+		require.NoError(t, err)
+		ids = append(ids, EncodeKSUID(k))
+	}
+
+	assert.True(t, sort.StringsAreSorted(ids))
+}
+
+func TestNewKSUIDInvalid(t *testing.T) {
+	_, err := NewKSUID(time.Unix(ksuidEpoch-1, 0), nil)
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = NewKSUID(time.Unix(ksuidEpoch+1<<32, 0), nil)
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = ParseKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLO")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = ParseKSUID("zzzzzzzzzzzzzzzzzzzzzzzzzzz")
+	assert.IsType(t, ErrOverflow{}, err)
+}
+
+func TestKSUIDScan(t *testing.T) {
+	var k KSUID
+	_, err := fmt.Sscanf("event 0ujtsYcgvSTl8PAuAdqWYSMnLOv", "event %s", &k)
+	require.NoError(t, err)
+	assert.Equal(t, "0ujtsYcgvSTl8PAuAdqWYSMnLOv", EncodeKSUID(k))
+}
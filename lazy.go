@@ -0,0 +1,35 @@
+package base62
+
+import (
+	"log/slog"
+)
+
+// LazyInt64 is an int64 which formats as its base62 encoding with the
+// StdEncoding, deferring encoding until it is formatted, such as by a log
+// call at an enabled level
+type LazyInt64 int64
+
+// String returns the base62 encoding of n
+func (n LazyInt64) String() string {
+	return EncodeInt64(int64(n))
+}
+
+// LogValue implements slog.LogValuer, which is only called when a record is
+// handled
+func (n LazyInt64) LogValue() slog.Value {
+	return slog.StringValue(n.String())
+}
+
+// LazyBytes are bytes which format as their base62 encoding with the
+// StdEncoding, as with LazyInt64
+type LazyBytes []byte
+
+// String returns the base62 encoding of b
+func (b LazyBytes) String() string {
+	return EncodeBytes(b)
+}
+
+// LogValue implements slog.LogValuer
+func (b LazyBytes) LogValue() slog.Value {
+	return slog.StringValue(b.String())
+}
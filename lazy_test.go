@@ -0,0 +1,45 @@
+package base62
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazyInt64(t *testing.T) {
+	n := LazyInt64(4815162342)
+	assert.Equal(t, "5Frvgk", n.String())
+	assert.Equal(t, "id=5Frvgk", fmt.Sprintf("id=%s", n))
+	assert.Equal(t, "id=5Frvgk", fmt.Sprintf("id=%v", n))
+}
+
+func TestLazyBytes(t *testing.T) {
+	b := LazyBytes{0xde, 0xad, 0xbe, 0xef}
+	assert.Equal(t, "44pZgF", b.String())
+	assert.Equal(t, "key=44pZgF", fmt.Sprintf("key=%v", b))
+}
+
+func TestLazySlog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("request", "id", LazyInt64(4815162342), "key", LazyBytes{0xde, 0xad, 0xbe, 0xef})
+	assert.Equal(t, "level=INFO msg=request id=5Frvgk key=44pZgF\n", buf.String())
+
+	// Nothing is encoded at disabled levels
+	buf.Reset()
+	assert.False(t, logger.Enabled(context.Background(), slog.LevelDebug))
+	logger.Debug("request", "id", LazyInt64(4815162342))
+	assert.Empty(t, buf.String())
+}
@@ -0,0 +1,32 @@
+package base62
+
+import (
+	"fmt"
+)
+
+// MaxDecodeLen sets the maximum length of strings accepted for decoding,
+// including any separators or padding, so untrusted input can't demand
+// unbounded work from DecodeToBigInt or DecodeBytes. Longer strings are
+// rejected with an ErrTooLong before any decoding. Zero removes the limit
+func MaxDecodeLen(n int) option {
+	return func(e *Encoding) {
+		e.maxDecodeLen = n
+	}
+}
+
+// checkLen returns an ErrTooLong if s exceeds the maximum decode length
+func (e *Encoding) checkLen(s string) error {
+	if e.maxDecodeLen > 0 && len(s) > e.maxDecodeLen {
+		return ErrTooLong{fmt.Errorf("Encoded length %d exceeds the maximum of %d", len(s), e.maxDecodeLen)}
+	}
+	return nil
+}
+
+// checkValue returns an ErrEmptyInput if s is empty, unless zero is encoded
+// as an empty string by EmptyZero, or an ErrTooLong as with checkLen
+func (e *Encoding) checkValue(s string) error {
+	if s == "" && !e.emptyZero {
+		return ErrEmptyInput{fmt.Errorf("Empty string has no value to decode")}
+	}
+	return e.checkLen(s)
+}
@@ -0,0 +1,35 @@
+package base62
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxDecodeLen(t *testing.T) {
+	e := NewStdEncoding().Option(MaxDecodeLen(8))
+
+	n, err := e.DecodeToInt64("005Frvgk")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4815162342), n)
+
+	long := strings.Repeat("z", 9)
+	_, err = e.DecodeToInt64(long)
+	assert.IsType(t, ErrTooLong{}, err)
+	_, err = e.DecodeToUint64(long)
+	assert.IsType(t, ErrTooLong{}, err)
+	_, err = e.DecodeBytes(long)
+	assert.IsType(t, ErrTooLong{}, err)
+	_, ok := e.TryDecodeToInt64(long)
+	assert.False(t, ok)
+
+	// Separators count towards the limit
+	_, err = e.Clone().Option(IgnoreSeparators("-")).DecodeToInt64("5Frv-gk---")
+	assert.IsType(t, ErrTooLong{}, err)
+
+	// Zero removes the limit
+	_, err = e.Clone().Option(MaxDecodeLen(0)).DecodeToUint64(long)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,132 @@
+package base62
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// LineTransform configures the transformation of newline delimited records
+// by TransformLines
+type LineTransform struct {
+	// Decode transforms base62 encoded values to decimal, rather than
+	// decimal values to base62
+	Decode bool
+
+	// Field is the 1-based index of the field to transform in each record,
+	// or zero to transform the whole line
+	Field int
+
+	// Separator splits records into fields, a tab if empty
+	Separator string
+}
+
+// TransformLines transforms newline delimited records using the
+// StdEncoding, see Encoding.TransformLines
+func TransformLines(dst io.Writer, src io.Reader, t LineTransform) error {
+	return StdEncoding.TransformLines(dst, src, t)
+}
+
+// TransformLines transforms newline delimited records with the encoding,
+// see TransformLinesWith
+func (e *Encoding) TransformLines(dst io.Writer, src io.Reader, t LineTransform) error {
+	return TransformLinesWith(e, dst, src, t)
+}
+
+// TransformLinesWith reads newline delimited records from src, encoding the
+// decimal int64 value of the configured field or line of each with the
+// Codec c, or decoding it back to decimal, and writes the records to dst. Records are
+// streamed one at a time, with output flushed whenever further input would
+// block, so it may sit within a log pipeline. Line endings are preserved and
+// the first record which fails to transform stops the stream with an error
+func TransformLinesWith(c Codec, dst io.Writer, src io.Reader, t LineTransform) error {
+	sep := t.Separator
+	if sep == "" {
+		sep = "\t"
+	}
+
+	var (
+		r = bufio.NewReader(src)
+		w = bufio.NewWriter(dst)
+	)
+
+	for n := 1; ; n++ {
+		line, readErr := r.ReadString('\n')
+		if readErr != nil && readErr != io.EOF {
+			return readErr
+		}
+		if line == "" {
+			break
+		}
+
+		// Set aside the line ending to restore after transforming
+		record, eol := line, ""
+		for _, suffix := range []string{"\r\n", "\n"} {
+			if strings.HasSuffix(record, suffix) {
+				record, eol = record[:len(record)-len(suffix)], suffix
+				break
+			}
+		}
+
+		record, err := transformRecord(c, record, sep, t)
+		if err != nil {
+			return fmt.Errorf("Line %d: %w", n, err)
+		}
+		if _, err := w.WriteString(record + eol); err != nil {
+			return err
+		}
+
+		// Flush before blocking on further input, so records are
+		// passed downstream as soon as they are available
+		if r.Buffered() == 0 {
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+	}
+
+	return w.Flush()
+}
+
+// transformRecord transforms the configured field of a single record
+func transformRecord(c Codec, record, sep string, t LineTransform) (string, error) {
+	if t.Field == 0 {
+		return transformValue(c, record, t.Decode)
+	}
+
+	fields := strings.Split(record, sep)
+	if t.Field < 0 || t.Field > len(fields) {
+		return "", ErrInvalidLength{fmt.Errorf("Record has %d fields, no field %d", len(fields), t.Field)}
+	}
+
+	v, err := transformValue(c, fields[t.Field-1], t.Decode)
+	if err != nil {
+		return "", err
+	}
+	fields[t.Field-1] = v
+
+	return strings.Join(fields, sep), nil
+}
+
+// transformValue encodes a decimal value with the Codec, or decodes it
+func transformValue(c Codec, s string, decode bool) (string, error) {
+	if decode {
+		n, err := c.DecodeToInt64(s)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(n, 10), nil
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return "", err
+	}
+	return c.EncodeInt64(n), nil
+}
@@ -0,0 +1,85 @@
+package base62
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransformLines(t *testing.T) {
+	testCases := []struct {
+		in        string
+		transform LineTransform
+		out       string
+	}{
+		{"", LineTransform{}, ""},
+		{"1\n62\n4815162342\n", LineTransform{}, "1\n10\n5Frvgk\n"},
+		{"1\n62", LineTransform{}, "1\n10"},
+		{"62\r\n", LineTransform{}, "10\r\n"},
+		{"1\n10\n5Frvgk\n", LineTransform{Decode: true}, "1\n62\n4815162342\n"},
+		{"GET\t62\t200\n", LineTransform{Field: 2}, "GET\t10\t200\n"},
+		{"GET,10,200\n", LineTransform{Decode: true, Field: 2, Separator: ","}, "GET,62,200\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("Transforming %q with %+v", tc.in, tc.transform)
+
+		var buf bytes.Buffer
+		require.NoError(t, TransformLines(&buf, strings.NewReader(tc.in), tc.transform))
+		assert.Equal(t, tc.out, buf.String())
+	}
+}
+
+func TestTransformLinesError(t *testing.T) {
+	testCases := []struct {
+		in        string
+		transform LineTransform
+		err       string
+	}{
+		{"1\nabc\n", LineTransform{}, "Line 2: "},
+		{"1\n1-0\n", LineTransform{Decode: true}, "Line 2: Invalid character - at 1"},
+		{"GET\t62\n", LineTransform{Field: 3}, "Line 1: Record has 2 fields, no field 3"},
+	}
+
+	for _, tc := range testCases {
+		var buf bytes.Buffer
+		err := TransformLines(&buf, strings.NewReader(tc.in), tc.transform)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), tc.err)
+	}
+}
+
+// chanWriter sends each write to a channel
+type chanWriter chan string
+
+func (w chanWriter) Write(p []byte) (int, error) {
+	w <- string(p)
+	return len(p), nil
+}
+
+// TestTransformLinesStreaming verifies each record is written downstream
+// before further input is available
+func TestTransformLinesStreaming(t *testing.T) {
+	pr, pw := io.Pipe()
+	out := make(chanWriter, 2)
+
+	done := make(chan error)
+	go func() {
+		done <- TransformLines(out, pr, LineTransform{})
+	}()
+
+	_, err := pw.Write([]byte("62\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "10\n", <-out)
+
+	_, err = pw.Write([]byte("63\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "11\n", <-out)
+
+	require.NoError(t, pw.Close())
+	require.NoError(t, <-done)
+}
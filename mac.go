@@ -0,0 +1,56 @@
+package base62
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/mattheath/base62/core"
+)
+
+// Fixed widths of encoded EUI-48 and EUI-64 hardware addresses
+var (
+	mac48Width = core.EncodedLen(6)
+	mac64Width = core.EncodedLen(8)
+)
+
+// EncodeMAC returns the base62 encoding of a hardware address using the
+// StdEncoding, see Encoding.EncodeMAC
+func EncodeMAC(mac net.HardwareAddr) (string, error) {
+	return StdEncoding.EncodeMAC(mac)
+}
+
+// DecodeMAC decodes a hardware address encoded by EncodeMAC using the
+// StdEncoding
+func DecodeMAC(s string) (net.HardwareAddr, error) {
+	return StdEncoding.DecodeMAC(s)
+}
+
+// EncodeMAC returns the base62 encoding of a 6 byte EUI-48 or 8 byte EUI-64
+// hardware address, as a fixed width token of 9 or 11 characters
+// respectively, such as for device identifiers in URLs
+func (e *Encoding) EncodeMAC(mac net.HardwareAddr) (string, error) {
+	if len(mac) != 6 && len(mac) != 8 {
+		return "", ErrInvalidLength{fmt.Errorf("Hardware address must be 6 or 8 bytes, got %d", len(mac))}
+	}
+
+	return e.encodeFixed(mac), nil
+}
+
+// DecodeMAC decodes a hardware address encoded by EncodeMAC
+func (e *Encoding) DecodeMAC(s string) (net.HardwareAddr, error) {
+	var mac net.HardwareAddr
+	switch len(s) {
+	case mac48Width:
+		mac = make(net.HardwareAddr, 6)
+	case mac64Width:
+		mac = make(net.HardwareAddr, 8)
+	default:
+		return nil, ErrInvalidLength{fmt.Errorf("Encoded hardware address must be %d or %d characters, got %d", mac48Width, mac64Width, len(s))}
+	}
+
+	if err := e.decodeFixed(s, mac); err != nil {
+		return nil, err
+	}
+
+	return mac, nil
+}
@@ -0,0 +1,49 @@
+package base62
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeMAC(t *testing.T) {
+	testcases := []struct {
+		mac     string
+		encoded string
+	}{
+		{"00:00:00:00:00:00", "000000000"},
+		{"00:00:5e:00:53:01", "0001ijGQz"},
+		{"ff:ff:ff:ff:ff:ff", "1HvWXNAa7"},
+		{"02:00:5e:10:00:00:00:01", "0AeWPWEOm3d"},
+	}
+
+	for _, tc := range testcases {
+		mac, err := net.ParseMAC(tc.mac)
+		require.NoError(t, err)
+
+		v, err := EncodeMAC(mac)
+		require.NoError(t, err)
+		t.Logf("Encoded %s as %s", mac, v)
+		assert.Equal(t, tc.encoded, v)
+
+		decoded, err := DecodeMAC(v)
+		require.NoError(t, err)
+		assert.Equal(t, mac, decoded)
+	}
+}
+
+func TestEncodeMACInvalid(t *testing.T) {
+	_, err := EncodeMAC(net.HardwareAddr{1, 2, 3})
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeMAC("0000000000")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeMAC("00000000-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+
+	_, err = DecodeMAC("zzzzzzzzz")
+	assert.IsType(t, ErrOverflow{}, err)
+}
@@ -0,0 +1,26 @@
+package base62
+
+import (
+	"math"
+)
+
+// WithMask sets integer values to be XORed with the mask before they are
+// encoded and after they are decoded, as a minimal obfuscation which hides
+// small values but not their order or count from anyone comparing several
+// IDs. For int64 values only the low 63 bits of the mask are applied, so
+// non-negative values remain non-negative, and negative values are left
+// unchanged. The mask is applied after any Permutation
+func WithMask(m uint64) option {
+	return func(e *Encoding) {
+		e.mask = m
+	}
+}
+
+// maskInt64 XORs a non-negative value with the mask, which is reversed by
+// applying it again
+func (e *Encoding) maskInt64(n int64) int64 {
+	if n < 0 {
+		return n
+	}
+	return n ^ int64(e.mask&math.MaxInt64)
+}
@@ -0,0 +1,76 @@
+package base62
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMask(t *testing.T) {
+	testCases := []struct {
+		opts    []option
+		n       int64
+		encoded string
+	}{
+		{nil, 0, "2LWGfS"},
+		{nil, 1, "2LWGfT"},
+		{nil, 2, "2LWGfQ"},
+		{nil, 0x802a5a5a, "0"},
+		{nil, math.MaxInt64, "AzL8myCYs6f"},
+		{[]option{Sign('-')}, -5, "-5"},
+		{[]option{Padding(8)}, 1, "002LWGfT"},
+		{[]option{Strict()}, 62, "2LWGfc"},
+	}
+
+	for _, tc := range testCases {
+		e := NewStdEncoding().Option(tc.opts...).Option(WithMask(0x802a5a5a))
+
+		s := e.EncodeInt64(tc.n)
+		t.Logf("Encoded %d as %q masked", tc.n, s)
+		assert.Equal(t, tc.encoded, s)
+		assert.Equal(t, s, string(e.AppendInt64(nil, tc.n)))
+
+		n, err := e.DecodeToInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, tc.n, n)
+
+		n, ok := e.TryDecodeToInt64(s)
+		assert.True(t, ok)
+		assert.Equal(t, tc.n, n)
+	}
+}
+
+func TestWithMaskUint64(t *testing.T) {
+	e := NewStdEncoding().Option(WithMask(1 << 63))
+
+	s := e.EncodeUint64(1)
+	assert.Equal(t, StdEncoding.EncodeUint64(1<<63|1), s)
+
+	n, err := e.DecodeToUint64(s)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), n)
+
+	// The sign bit of the mask is not applied to int64 values
+	assert.Equal(t, "1", e.EncodeInt64(1))
+}
+
+func TestWithMaskPermute(t *testing.T) {
+	e := NewStdEncoding().Option(Permute(offsetPermutation{}), WithMask(0xff))
+	assert.Equal(t, StdEncoding.EncodeInt64(1000^0xff), e.EncodeInt64(0))
+
+	n, err := e.DecodeToInt64(e.EncodeInt64(12345))
+	require.NoError(t, err)
+	assert.Equal(t, int64(12345), n)
+}
+
+func TestWithMaskString(t *testing.T) {
+	e := NewStdEncoding().Option(WithMask(0xff))
+	assert.Contains(t, e.String(), "mask: 0xff")
+	assert.Equal(t,
+		`base62.NewEncoding("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz").Option(base62.WithMask(0xff))`,
+		fmt.Sprintf("%#v", e))
+	assert.True(t, e.Equal(NewStdEncoding().Option(WithMask(0xff))))
+}
@@ -0,0 +1,152 @@
+package base62
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Monotonic generates time based IDs which strictly increase within a
+// process. When an ID is created in the same timestamp as the previous ID
+// of its kind, or the clock has moved backwards, the previous timestamp is
+// kept and its random component is incremented rather than drawn afresh.
+// It is safe for concurrent use
+type Monotonic struct {
+	mu      sync.Mutex
+	e       *Encoding
+	entropy io.Reader
+
+	ulid   ULID
+	ksuid  KSUID
+	timeID string
+}
+
+// NewMonotonic returns a Monotonic generator using the StdEncoding
+func NewMonotonic(entropy io.Reader) *Monotonic {
+	return StdEncoding.NewMonotonic(entropy)
+}
+
+// NewMonotonic returns a Monotonic generator reading random components from
+// entropy, or crypto/rand if entropy is nil
+func (e *Encoding) NewMonotonic(entropy io.Reader) *Monotonic {
+	if entropy == nil {
+		entropy = rand.Reader
+	}
+
+	return &Monotonic{e: e, entropy: entropy}
+}
+
+// ULID returns a ULID for time t, greater than any previously returned. An
+// ErrOverflow is returned if the random component can't be incremented
+func (m *Monotonic) ULID(t time.Time) (ULID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.ulid != (ULID{}) && t.UnixMilli() <= m.ulid.Time().UnixMilli() {
+		u := m.ulid
+		if !incrementBytes(u[6:]) {
+			return ULID{}, ErrOverflow{fmt.Errorf("Random component of ULID overflows within %v", u.Time())}
+		}
+		m.ulid = u
+		return u, nil
+	}
+
+	u, err := NewULID(t, m.entropy)
+	if err != nil {
+		return ULID{}, err
+	}
+	m.ulid = u
+	return u, nil
+}
+
+// KSUID returns a KSUID for time t, greater than any previously returned.
+// An ErrOverflow is returned if the random component can't be incremented
+func (m *Monotonic) KSUID(t time.Time) (KSUID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.ksuid != (KSUID{}) && t.Unix() <= m.ksuid.Timestamp().Unix() {
+		k := m.ksuid
+		if !incrementBytes(k[4:]) {
+			return KSUID{}, ErrOverflow{fmt.Errorf("Random component of KSUID overflows within %v", k.Timestamp())}
+		}
+		m.ksuid = k
+		return k, nil
+	}
+
+	k, err := NewKSUID(t, m.entropy)
+	if err != nil {
+		return KSUID{}, err
+	}
+	m.ksuid = k
+	return k, nil
+}
+
+// TimeID returns a time prefixed ID as from NewTimeID, greater than any
+// previously returned with the same randomLen. Incremented random characters
+// skip over any containing words of the Blocklist, and an ErrOverflow is
+// returned if they can't be incremented
+func (m *Monotonic) TimeID(t time.Time, randomLen int) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.timeID) == TimeIDPrefixLen+randomLen && randomLen > 0 {
+		last, err := m.e.ParseTimeID(m.timeID)
+		if err != nil {
+			return "", err
+		}
+
+		if t.UnixMilli() <= last.UnixMilli() {
+			d, err := m.e.digits(m.timeID[TimeIDPrefixLen:])
+			if err != nil {
+				return "", err
+			}
+
+			// Skip every suffix containing a blocked word by incrementing
+			// the last character of the word and zeroing any after it
+			suffix := make([]byte, randomLen)
+			for d = addDigits(d, 1); len(d) <= randomLen; {
+				for i, v := range d {
+					suffix[i] = m.e.encode[v]
+				}
+
+				end := m.e.blockedEnd(string(suffix))
+				if end == -1 {
+					break
+				}
+				for i := end; i < len(d); i++ {
+					d[i] = 0
+				}
+				d = append(addDigits(d[:end], 1), d[end:]...)
+			}
+			if len(d) > randomLen {
+				return "", ErrOverflow{fmt.Errorf("Random component of time ID overflows within %v", last)}
+			}
+
+			s := m.timeID[:TimeIDPrefixLen] + string(suffix)
+			m.timeID = s
+			return s, nil
+		}
+	}
+
+	s, err := m.e.newTimeID(m.entropy, t, randomLen)
+	if err != nil {
+		return "", err
+	}
+	m.timeID = s
+	return s, nil
+}
+
+// incrementBytes adds one to a big-endian value in place, returning false
+// if it overflows
+func incrementBytes(b []byte) bool {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i]++
+		if b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package base62
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonotonicULID(t *testing.T) {
+	var (
+		m   = NewMonotonic(nil)
+		now = time.UnixMilli(1700000000123)
+		ids []string
+	)
+
+	for i := 0; i < 100; i++ {
+		u, err := m.ULID(now)
+		require.NoError(t, err)
+		assert.True(t, now.Equal(u.Time()))
+		ids = append(ids, EncodeULID(u))
+	}
+
+	// A clock moving backwards keeps the previous timestamp
+	u, err := m.ULID(now.Add(-time.Second))
+	require.NoError(t, err)
+	assert.True(t, now.Equal(u.Time()))
+	ids = append(ids, EncodeULID(u))
+
+	u, err = m.ULID(now.Add(time.Millisecond))
+	require.NoError(t, err)
+	ids = append(ids, EncodeULID(u))
+
+	assert.True(t, sort.StringsAreSorted(ids))
+	for i := 1; i < len(ids); i++ {
+		assert.NotEqual(t, ids[i-1], ids[i])
+	}
+}
+
+func TestMonotonicULIDIncrement(t *testing.T) {
+	m := NewMonotonic(bytes.NewReader(append(bytes.Repeat([]byte{0}, 9), 0xfe)))
+	now := time.UnixMilli(1)
+
+	u, err := m.ULID(now)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0xfe), u[15])
+
+	u, err = m.ULID(now)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0xff), u[15])
+
+	u, err = m.ULID(now)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x00}, u[14:])
+}
+
+func TestMonotonicULIDOverflow(t *testing.T) {
+	m := NewMonotonic(bytes.NewReader(bytes.Repeat([]byte{0xff}, 10)))
+
+	_, err := m.ULID(time.UnixMilli(1))
+	require.NoError(t, err)
+
+	_, err = m.ULID(time.UnixMilli(1))
+	assert.IsType(t, ErrOverflow{}, err)
+}
+
+func TestMonotonicKSUID(t *testing.T) {
+	var (
+		m   = NewMonotonic(nil)
+		now = time.Unix(1700000000, 0)
+		ids []string
+	)
+
+	for i := 0; i < 100; i++ {
+		k, err := m.KSUID(now)
+		require.NoError(t, err)
+		ids = append(ids, EncodeKSUID(k))
+	}
+
+	assert.True(t, sort.StringsAreSorted(ids))
+	assert.NotEqual(t, ids[0], ids[1])
+}
+
+func TestMonotonicTimeID(t *testing.T) {
+	m := NewMonotonic(bytes.NewReader([]byte{0, 0, 0, 61, 61, 0}))
+	now := time.UnixMilli(1700000000123)
+
+	s, err := m.TimeID(now, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "0Tvcokih00", s)
+
+	s, err = m.TimeID(now, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "0Tvcokih01", s)
+
+	// A new millisecond draws a fresh random component
+	s, err = m.TimeID(now.Add(time.Millisecond), 2)
+	require.NoError(t, err)
+	assert.Equal(t, "0Tvcokiizz", s)
+
+	_, err = m.TimeID(now.Add(time.Millisecond), 2)
+	assert.IsType(t, ErrOverflow{}, err)
+}
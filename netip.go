@@ -0,0 +1,113 @@
+package base62
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/mattheath/base62/core"
+)
+
+// Fixed widths of encoded network values, which identify the address family
+var (
+	prefix4Width = core.EncodedLen(4 + 1)
+	prefix6Width = core.EncodedLen(16 + 1)
+
+	addrPort4Width = core.EncodedLen(4 + 2)
+	addrPort6Width = core.EncodedLen(16 + 2)
+)
+
+// EncodePrefix returns the base62 encoding of a network prefix using the
+// StdEncoding, see Encoding.EncodePrefix
+func EncodePrefix(p netip.Prefix) (string, error) {
+	return StdEncoding.EncodePrefix(p)
+}
+
+// DecodePrefix decodes a network prefix encoded by EncodePrefix using the
+// StdEncoding
+func DecodePrefix(s string) (netip.Prefix, error) {
+	return StdEncoding.DecodePrefix(s)
+}
+
+// EncodePrefix returns the base62 encoding of a network prefix, packing the
+// address and prefix length into a single fixed width token of 7 characters
+// for IPv4 and 23 characters for IPv6. The address is not masked, so any
+// host bits are preserved
+func (e *Encoding) EncodePrefix(p netip.Prefix) (string, error) {
+	if !p.IsValid() {
+		return "", ErrInvalidValue{fmt.Errorf("Cannot encode invalid prefix %s", p)}
+	}
+
+	b := p.Addr().AsSlice()
+	return e.encodeFixed(append(b, byte(p.Bits()))), nil
+}
+
+// DecodePrefix decodes a network prefix encoded by EncodePrefix
+func (e *Encoding) DecodePrefix(s string) (netip.Prefix, error) {
+	var b []byte
+	switch len(s) {
+	case prefix4Width:
+		b = make([]byte, 4+1)
+	case prefix6Width:
+		b = make([]byte, 16+1)
+	default:
+		return netip.Prefix{}, ErrInvalidLength{fmt.Errorf("Encoded prefix must be %d or %d characters, got %d", prefix4Width, prefix6Width, len(s))}
+	}
+
+	if err := e.decodeFixed(s, b); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	addr, _ := netip.AddrFromSlice(b[:len(b)-1])
+	bits := int(b[len(b)-1])
+	if bits > addr.BitLen() {
+		return netip.Prefix{}, ErrOverflow{fmt.Errorf("Prefix length %d exceeds %d bits", bits, addr.BitLen())}
+	}
+
+	return netip.PrefixFrom(addr, bits), nil
+}
+
+// EncodeAddrPort returns the base62 encoding of an address and port using
+// the StdEncoding, see Encoding.EncodeAddrPort
+func EncodeAddrPort(ap netip.AddrPort) (string, error) {
+	return StdEncoding.EncodeAddrPort(ap)
+}
+
+// DecodeAddrPort decodes an address and port encoded by EncodeAddrPort
+// using the StdEncoding
+func DecodeAddrPort(s string) (netip.AddrPort, error) {
+	return StdEncoding.DecodeAddrPort(s)
+}
+
+// EncodeAddrPort returns the base62 encoding of an address and port, packing
+// both into a single fixed width token of 9 characters for IPv4 and 25
+// characters for IPv6, such as for compact peer identifiers. Any IPv6 zone
+// is not encoded
+func (e *Encoding) EncodeAddrPort(ap netip.AddrPort) (string, error) {
+	if !ap.IsValid() {
+		return "", ErrInvalidValue{fmt.Errorf("Cannot encode invalid address and port %s", ap)}
+	}
+
+	b := ap.Addr().AsSlice()
+	return e.encodeFixed(binary.BigEndian.AppendUint16(b, ap.Port())), nil
+}
+
+// DecodeAddrPort decodes an address and port encoded by EncodeAddrPort
+func (e *Encoding) DecodeAddrPort(s string) (netip.AddrPort, error) {
+	var b []byte
+	switch len(s) {
+	case addrPort4Width:
+		b = make([]byte, 4+2)
+	case addrPort6Width:
+		b = make([]byte, 16+2)
+	default:
+		return netip.AddrPort{}, ErrInvalidLength{fmt.Errorf("Encoded address and port must be %d or %d characters, got %d", addrPort4Width, addrPort6Width, len(s))}
+	}
+
+	if err := e.decodeFixed(s, b); err != nil {
+		return netip.AddrPort{}, err
+	}
+
+	addr, _ := netip.AddrFromSlice(b[:len(b)-2])
+	return netip.AddrPortFrom(addr, binary.BigEndian.Uint16(b[len(b)-2:])), nil
+}
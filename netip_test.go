@@ -0,0 +1,103 @@
+package base62
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodePrefix(t *testing.T) {
+	testcases := []struct {
+		prefix string
+		width  int
+	}{
+		{"0.0.0.0/0", 7},
+		{"10.0.0.0/8", 7},
+		{"192.168.1.17/24", 7},
+		{"255.255.255.255/32", 7},
+		{"::/0", 23},
+		{"2001:db8::/32", 23},
+		{"::ffff:10.0.0.1/128", 23},
+		{"ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff/128", 23},
+	}
+
+	for _, tc := range testcases {
+		p := netip.MustParsePrefix(tc.prefix)
+
+		v, err := EncodePrefix(p)
+		require.NoError(t, err)
+		t.Logf("Encoded %s as %s", p, v)
+		assert.Len(t, v, tc.width)
+
+		decoded, err := DecodePrefix(v)
+		require.NoError(t, err)
+		assert.Equal(t, p, decoded)
+	}
+}
+
+func TestEncodePrefixSortsByAddress(t *testing.T) {
+	a, err := EncodePrefix(netip.MustParsePrefix("10.0.0.0/8"))
+	require.NoError(t, err)
+	b, err := EncodePrefix(netip.MustParsePrefix("10.0.1.0/24"))
+	require.NoError(t, err)
+
+	assert.True(t, a < b)
+}
+
+func TestEncodePrefixInvalid(t *testing.T) {
+	_, err := EncodePrefix(netip.Prefix{})
+	assert.IsType(t, ErrInvalidValue{}, err)
+}
+
+func TestDecodePrefixInvalid(t *testing.T) {
+	_, err := DecodePrefix("0000000000")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	// 0.0.0.0/33
+	v := StdEncoding.encodeFixed([]byte{0, 0, 0, 0, 33})
+	_, err = DecodePrefix(v)
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = DecodePrefix("000000-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestEncodeAddrPort(t *testing.T) {
+	testcases := []struct {
+		addrPort string
+		width    int
+	}{
+		{"0.0.0.0:0", 9},
+		{"10.0.0.1:443", 9},
+		{"255.255.255.255:65535", 9},
+		{"[::]:0", 25},
+		{"[2001:db8::1]:8080", 25},
+		{"[ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff]:65535", 25},
+	}
+
+	for _, tc := range testcases {
+		ap := netip.MustParseAddrPort(tc.addrPort)
+
+		v, err := EncodeAddrPort(ap)
+		require.NoError(t, err)
+		t.Logf("Encoded %s as %s", ap, v)
+		assert.Len(t, v, tc.width)
+
+		decoded, err := DecodeAddrPort(v)
+		require.NoError(t, err)
+		assert.Equal(t, ap, decoded)
+	}
+}
+
+func TestEncodeAddrPortInvalid(t *testing.T) {
+	_, err := EncodeAddrPort(netip.AddrPort{})
+	assert.IsType(t, ErrInvalidValue{}, err)
+
+	_, err = DecodeAddrPort("0000000")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeAddrPort("00000000-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
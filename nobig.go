@@ -0,0 +1,13 @@
+//go:build base62_nobig
+
+package base62
+
+// selfTestBig has no big integers to verify when they are excluded
+func (e *Encoding) selfTestBig() error {
+	return nil
+}
+
+// bigTestVectors has no big integer vectors when they are excluded
+func (e *Encoding) bigTestVectors() []TestVector {
+	return nil
+}
@@ -0,0 +1,48 @@
+package base62
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// NullInt62 is an Int62 which may be null, as with sql.NullInt64, for
+// nullable columns such as optional foreign keys. Null values marshal as
+// JSON null, and the zero value is null
+type NullInt62 struct {
+	Int62 Int62
+
+	// Valid is true if Int62 is not null
+	Valid bool
+}
+
+// MarshalJSON implements json.Marshaler, as the base62 string or null
+func (n NullInt62) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Int62)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, from a base62 string or null
+func (n *NullInt62) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		n.Int62, n.Valid = 0, false
+		return nil
+	}
+
+	if err := json.Unmarshal(b, &n.Int62); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// IsZero returns whether n is null
+func (n NullInt62) IsZero() bool {
+	return !n.Valid
+}
+
+// GormDataType returns the column type of a NullInt62 for GORM migrations
+func (NullInt62) GormDataType() string {
+	return "bigint"
+}
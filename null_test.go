@@ -0,0 +1,39 @@
+package base62
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullInt62JSON(t *testing.T) {
+	type record struct {
+		ID     Int62     `json:"id"`
+		Parent NullInt62 `json:"parent"`
+	}
+
+	testCases := []struct {
+		r    record
+		json string
+	}{
+		{record{4815162342, NullInt62{62, true}}, `{"id":"5Frvgk","parent":"10"}`},
+		{record{4815162342, NullInt62{0, true}}, `{"id":"5Frvgk","parent":"0"}`},
+		{record{4815162342, NullInt62{}}, `{"id":"5Frvgk","parent":null}`},
+	}
+
+	for _, tc := range testCases {
+		b, err := json.Marshal(tc.r)
+		require.NoError(t, err)
+		t.Logf("Marshalled %+v as %s", tc.r, b)
+		assert.Equal(t, tc.json, string(b))
+
+		v := record{Parent: NullInt62{1, true}}
+		require.NoError(t, json.Unmarshal(b, &v))
+		assert.Equal(t, tc.r, v)
+	}
+
+	var v record
+	assert.Error(t, json.Unmarshal([]byte(`{"parent":"5Frv-gk"}`), &v))
+}
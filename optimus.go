@@ -0,0 +1,170 @@
+package base62
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+// Optimus is a Permutation of the values of a 2^n bit ID space, by
+// multiplication with a prime modulo 2^n followed by an XOR with a random
+// key, as in the Optimus library. It is cheaper than a Feistel network but
+// mixes values less thoroughly. Values beyond the ID space are unchanged
+type Optimus struct {
+	prime   uint64
+	inverse uint64
+	random  uint64
+	mask    uint64
+}
+
+var _ Permutation = (*Optimus)(nil)
+
+// NewOptimus returns an Optimus permutation of a space of width bits,
+// typically 31 or 63, with the prime and random keys. An ErrInvalidConfig
+// is returned if the prime is not an odd prime within the space, or the
+// width is not between 2 and 63 bits
+func NewOptimus(prime, random uint64, width int) (*Optimus, error) {
+	if width < 2 || width > 63 {
+		return nil, ErrInvalidConfig{fmt.Errorf("Optimus width %d must be between 2 and 63 bits", width)}
+	}
+
+	mask := uint64(1)<<width - 1
+	if prime > mask || prime%2 == 0 || !isPrime(prime) {
+		return nil, ErrInvalidConfig{fmt.Errorf("Optimus prime %d is not an odd prime of %d bits", prime, width)}
+	}
+
+	return &Optimus{
+		prime:   prime,
+		inverse: modInverse(prime) & mask,
+		random:  random & mask,
+		mask:    mask,
+	}, nil
+}
+
+// GenerateOptimus returns an Optimus permutation of a space of width bits
+// with keys read from entropy, or crypto/rand if entropy is nil. The keys
+// should be generated once and stored, see Optimus.Prime and Optimus.Random
+func GenerateOptimus(width int, entropy io.Reader) (*Optimus, error) {
+	if width < 2 || width > 63 {
+		return nil, ErrInvalidConfig{fmt.Errorf("Optimus width %d must be between 2 and 63 bits", width)}
+	}
+	if entropy == nil {
+		entropy = rand.Reader
+	}
+
+	var (
+		mask = uint64(1)<<width - 1
+		buf  [8]byte
+	)
+	for {
+		if _, err := io.ReadFull(entropy, buf[:]); err != nil {
+			return nil, err
+		}
+		if prime := binary.BigEndian.Uint64(buf[:])&mask | 1; prime > 1 && isPrime(prime) {
+			if _, err := io.ReadFull(entropy, buf[:]); err != nil {
+				return nil, err
+			}
+			return NewOptimus(prime, binary.BigEndian.Uint64(buf[:]), width)
+		}
+	}
+}
+
+// Prime returns the prime key
+func (o *Optimus) Prime() uint64 {
+	return o.prime
+}
+
+// Inverse returns the modular multiplicative inverse of the prime key
+func (o *Optimus) Inverse() uint64 {
+	return o.inverse
+}
+
+// Random returns the random key
+func (o *Optimus) Random() uint64 {
+	return o.random
+}
+
+// Permute maps n to its permuted value, leaving negative values and those
+// beyond the ID space unchanged
+func (o *Optimus) Permute(n int64) int64 {
+	if n < 0 || uint64(n) > o.mask {
+		return n
+	}
+	return int64((uint64(n)*o.prime)&o.mask ^ o.random)
+}
+
+// Unpermute reverses Permute
+func (o *Optimus) Unpermute(n int64) int64 {
+	if n < 0 || uint64(n) > o.mask {
+		return n
+	}
+	return int64(((uint64(n) ^ o.random) * o.inverse) & o.mask)
+}
+
+// modInverse returns the multiplicative inverse of odd n modulo 2^64, by
+// Newton's method which doubles the correct bits with each iteration
+func modInverse(n uint64) uint64 {
+	x := n
+	for i := 0; i < 5; i++ {
+		x *= 2 - n*x
+	}
+	return x
+}
+
+// isPrime returns whether n is prime, by the Miller-Rabin test with bases
+// which are deterministic for all 64 bit values
+func isPrime(n uint64) bool {
+	bases := []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+	if n < 2 {
+		return false
+	}
+	for _, p := range bases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+
+	// Write n-1 as d*2^s with d odd
+	d := n - 1
+	s := bits.TrailingZeros64(d)
+	d >>= s
+
+	for _, a := range bases {
+		x := powMod(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+
+		composite := true
+		for i := 1; i < s; i++ {
+			if x = mulMod(x, x, n); x == n-1 {
+				composite = false
+				break
+			}
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
+
+// mulMod returns a*b mod m without overflow
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// powMod returns a^e mod m by square and multiply
+func powMod(a, e, m uint64) uint64 {
+	r := uint64(1)
+	for a %= m; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			r = mulMod(r, a, m)
+		}
+		a = mulMod(a, a, m)
+	}
+	return r
+}
@@ -0,0 +1,106 @@
+package base62
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimus(t *testing.T) {
+	// Keys and values from the Optimus documentation
+	o, err := NewOptimus(1580030173, 1163945558, 31)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(59260789), o.Inverse())
+
+	testCases := []struct {
+		n        int64
+		permuted int64
+	}{
+		{15, 1103647397},
+		{0, 1163945558},
+		{-1, -1},
+		{math.MaxInt32 + 1, math.MaxInt32 + 1},
+	}
+
+	for _, tc := range testCases {
+		p := o.Permute(tc.n)
+		t.Logf("Permuted %d to %d", tc.n, p)
+		assert.Equal(t, tc.permuted, p)
+		assert.Equal(t, tc.n, o.Unpermute(p))
+	}
+}
+
+func TestOptimusInvalid(t *testing.T) {
+	testCases := []struct {
+		prime uint64
+		width int
+	}{
+		{1580030173, 1},
+		{1580030173, 64},
+		{1580030173, 30},
+		{1580030172, 31},
+		{1580030175, 31},
+		{2, 31},
+	}
+
+	for _, tc := range testCases {
+		_, err := NewOptimus(tc.prime, 0, tc.width)
+		t.Logf("Prime %d of %d bits: %v", tc.prime, tc.width, err)
+		assert.IsType(t, ErrInvalidConfig{}, err)
+	}
+}
+
+func TestGenerateOptimus(t *testing.T) {
+	for _, width := range []int{8, 31, 63} {
+		o, err := GenerateOptimus(width, nil)
+		require.NoError(t, err)
+		t.Logf("Generated %d bit prime %d, inverse %d, random %d", width, o.Prime(), o.Inverse(), o.Random())
+		assert.True(t, isPrime(o.Prime()))
+
+		for n := int64(0); n < 1000; n++ {
+			require.Equal(t, n, o.Unpermute(o.Permute(n)))
+		}
+	}
+
+	_, err := GenerateOptimus(31, bytes.NewReader(nil))
+	assert.Error(t, err)
+}
+
+func TestOptimusEncoding(t *testing.T) {
+	o, err := NewOptimus(1580030173, 1163945558, 31)
+	require.NoError(t, err)
+	e := NewStdEncoding().Option(Permute(o))
+
+	s := e.EncodeInt64(15)
+	assert.Equal(t, StdEncoding.EncodeInt64(1103647397), s)
+
+	n, err := e.DecodeToInt64(s)
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), n)
+}
+
+func TestIsPrime(t *testing.T) {
+	testCases := []struct {
+		n     uint64
+		prime bool
+	}{
+		{0, false},
+		{1, false},
+		{2, true},
+		{9, false},
+		{37, true},
+		{561, false},
+		{1580030173, true},
+		{math.MaxInt64, false},
+		{1<<61 - 1, true},
+		{18446744073709551557, true},
+		{3215031751, false},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.prime, isPrime(tc.n), "%d", tc.n)
+	}
+}
@@ -0,0 +1,64 @@
+package base62
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PackInt64 packs multiple values into a single string using the
+// StdEncoding, see Encoding.PackInt64
+func PackInt64(values ...int64) (string, error) {
+	return StdEncoding.PackInt64(values...)
+}
+
+// UnpackInt64 unpacks a string created by PackInt64 using the StdEncoding
+func UnpackInt64(s string) ([]int64, error) {
+	return StdEncoding.UnpackInt64(s)
+}
+
+// PackInt64 packs multiple values into a single string, each value is
+// encoded without padding and prefixed by a single character holding the
+// length of its encoding. Unlike fixed width tuples, small components remain
+// short however large their neighbours. Values are mapped through any
+// Permutation and mask, and negative values are encoded with the Sign or
+// ZigZag encoding. Options
+// formatting whole strings, such as Padding, Group and Luhn, are not
+// applied to the packed values
+func (e *Encoding) PackInt64(values ...int64) (string, error) {
+	var b strings.Builder
+
+	for _, n := range values {
+		s := e.encodeValue(n)
+		b.WriteByte(e.encode[len(s)])
+		b.WriteString(s)
+	}
+
+	return b.String(), nil
+}
+
+// UnpackInt64 unpacks a string created by PackInt64
+func (e *Encoding) UnpackInt64(s string) ([]int64, error) {
+	var values []int64
+
+	for i := 0; i < len(s); {
+		// Read the length prefix, then the value following it
+		l := e.index(s[i])
+		if l == -1 {
+			return nil, e.invalidCharacter(s, i)
+		}
+		i++
+
+		if i+l > len(s) {
+			return nil, ErrInvalidLength{fmt.Errorf("Packed value at %d truncated, expected %d characters", i, l)}
+		}
+
+		n, err := e.decodeValue(s[i : i+l])
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, e.permutation.unpermute(e.maskInt64(n)))
+		i += l
+	}
+
+	return values, nil
+}
@@ -0,0 +1,127 @@
+package base62
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackInt64(t *testing.T) {
+	testcases := []struct {
+		values []int64
+		packed string
+	}{
+		{nil, ""},
+		{[]int64{0}, "0"},
+		{[]int64{1}, "11"},
+		{[]int64{62, 0, 1}, "210011"},
+		{[]int64{4815162342, 7}, "65Frvgk17"},
+		{[]int64{math.MaxInt64, 3844}, "BAzL8n0Y58m73100"},
+	}
+
+	for _, tc := range testcases {
+		v, err := PackInt64(tc.values...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Packed %v as %s", tc.values, v)
+		assert.Equal(t, tc.packed, v)
+
+		values, err := UnpackInt64(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.values, values)
+	}
+}
+
+func TestPackInt64Padded(t *testing.T) {
+	e := NewStdEncoding().Option(Padding(10))
+
+	v, err := e.PackInt64(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "1112", v)
+}
+
+func TestPackInt64ZigZag(t *testing.T) {
+	e := NewStdEncoding().Option(ZigZag())
+
+	values := []int64{-1, 0, math.MinInt64, 4815162342}
+	v, err := e.PackInt64(values...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unpacked, err := e.UnpackInt64(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, values, unpacked)
+}
+
+func TestUnpackInt64Invalid(t *testing.T) {
+	_, err := UnpackInt64("31")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = UnpackInt64("11-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+
+	_, err = UnpackInt64("2~1")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestPackInt64Options(t *testing.T) {
+	var (
+		unsigned = []int64{0, 5, 1000, math.MaxInt64}
+		signed   = []int64{0, 5, -5, 1000, math.MaxInt64, math.MinInt64}
+	)
+
+	testcases := []struct {
+		opts   []option
+		values []int64
+	}{
+		{[]option{WithMask(0xff)}, unsigned},
+		{[]option{Luhn()}, unsigned},
+		{[]option{Strict()}, unsigned},
+		{[]option{WithMask(0xff), Luhn(), Padding(8), Group(2, "-")}, unsigned},
+		{[]option{Sign('~')}, signed},
+		{[]option{Sign('~'), WithMask(0xff), Luhn()}, signed},
+		{[]option{Sortable()}, signed},
+		{[]option{KnuthHash(), ZigZag()}, signed},
+	}
+
+	for _, tc := range testcases {
+		e := NewStdEncoding().Option(tc.opts...)
+
+		v, err := e.PackInt64(tc.values...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Packed %v as %s with %v", tc.values, v, e)
+
+		unpacked, err := e.UnpackInt64(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tc.values, unpacked)
+	}
+}
+
+func TestPackInt64Negative(t *testing.T) {
+	s, err := PackInt64(1, -5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "112-5", s)
+
+	// Alphabets including the default marker pack with their Sign
+	dash := NewEncoding("-123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz", Sign('~'))
+	s, err = dash.PackInt64(1, -5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "112~5", s)
+}
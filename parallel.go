@@ -0,0 +1,109 @@
+package base62
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"unicode/utf8"
+)
+
+// EncodeParallel encodes src across multiple goroutines using the
+// StdEncoding, see Encoding.EncodeParallel
+func EncodeParallel(src []byte, workers int) []byte {
+	return StdEncoding.EncodeParallel(src, workers)
+}
+
+// DecodeParallel decodes src across multiple goroutines using the
+// StdEncoding, see Encoding.DecodeParallel
+func DecodeParallel(src []byte, workers int) ([]byte, error) {
+	return StdEncoding.DecodeParallel(src, workers)
+}
+
+// EncodeParallel encodes arbitrarily large binary data by splitting it into
+// blocks of BlockSize bytes, encoding each to a fixed width across workers
+// goroutines and concatenating the results in order. Small blocks keep the
+// encoding within a fraction of a percent of the minimum possible length,
+// while allowing very large inputs to use all cores. The output is
+// identical to EncodeToString, including any Transforms. If workers is not
+// positive GOMAXPROCS goroutines are used
+func (e *Encoding) EncodeParallel(src []byte, workers int) []byte {
+	src = e.transforms.encode(src)
+	dst := make([]byte, EncodedLen(len(src)))
+
+	parallel(len(src)/BlockSize+1, workers, func(i int) error {
+		e.putBlocks(block(dst, i, blockWidth), block(src, i, BlockSize))
+		return nil
+	})
+
+	return dst
+}
+
+// DecodeParallel decodes data encoded by EncodeParallel or EncodeToString,
+// decoding blocks across workers goroutines. If workers is not positive
+// GOMAXPROCS goroutines are used
+func (e *Encoding) DecodeParallel(src []byte, workers int) ([]byte, error) {
+	n, ok := bytesLen(len(src))
+	if !ok {
+		return nil, ErrInvalidLength{fmt.Errorf("Encoded length %d is not a valid length of blocks", len(src))}
+	}
+
+	dst := make([]byte, n)
+	err := parallel(n/BlockSize+1, workers, func(i int) error {
+		s := block(src, i, blockWidth)
+
+		// Report invalid characters at their offset in the whole input,
+		// without copying it to suggest a correction
+		for j, c := range s {
+			if e.index(c) == -1 {
+				offset := i*blockWidth + j
+				v, _ := utf8.DecodeRune(src[offset:])
+				return e.invalidRune(v, offset, "")
+			}
+		}
+
+		return e.decodeBlocks(block(dst, i, BlockSize), string(s))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return e.transforms.decode(dst)
+}
+
+// parallel calls fn for each of n blocks across workers goroutines, with
+// each goroutine taking a contiguous range of blocks. The error from the
+// earliest failing block is returned
+func parallel(n, workers int, fn func(i int) error) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, n)
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, workers)
+		per  = (n + workers - 1) / workers
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w * per; i < min((w+1)*per, n); i++ {
+				if err := fn(i); err != nil {
+					errs[w] = err
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package base62
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeParallel(t *testing.T) {
+	for _, size := range []int{0, 1, 31, 32, 33, 64, 1000, 100000} {
+		src := make([]byte, size)
+		_, err := rand.Read(src)
+		require.NoError(t, err)
+
+		for _, workers := range []int{0, 1, 3, 64} {
+			v := EncodeParallel(src, workers)
+
+			decoded, err := DecodeParallel(v, workers)
+			require.NoError(t, err)
+			assert.True(t, bytes.Equal(src, decoded), "size %d workers %d", size, workers)
+		}
+	}
+}
+
+func TestEncodeParallelBlocks(t *testing.T) {
+	src := make([]byte, BlockSize*2+16)
+	_, err := rand.Read(src)
+	require.NoError(t, err)
+
+	v := EncodeParallel(src, 4)
+	assert.Len(t, v, 2*43+22)
+	assert.Equal(t, string(EncodeParallel(src, 1)), string(v))
+
+	// Each block is the fixed width encoding of its bytes
+	assert.Equal(t, EncodeArray([32]byte(src[:32])), string(v[:43]))
+	assert.Equal(t, EncodeArray([32]byte(src[32:64])), string(v[43:86]))
+	assert.Equal(t, EncodeArray([16]byte(src[64:])), string(v[86:]))
+}
+
+func TestEncodeParallelFormat(t *testing.T) {
+	src := make([]byte, BlockSize*3+7)
+	_, err := rand.Read(src)
+	require.NoError(t, err)
+
+	// The output is that of EncodeToString, so either decodes the other
+	for _, e := range []*Encoding{StdEncoding, NewStdEncoding().Option(Transforms(XORKey([]byte{0x5a}), ReverseBytes()))} {
+		v := e.EncodeParallel(src, 3)
+		assert.Equal(t, e.EncodeToString(src), string(v))
+
+		decoded, err := e.DecodeString(string(v))
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(src, decoded))
+
+		decoded, err = e.DecodeParallel([]byte(e.EncodeToString(src)), 3)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(src, decoded))
+	}
+}
+
+func TestDecodeParallelInvalid(t *testing.T) {
+	_, err := DecodeParallel([]byte("0"), 0)
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	v := EncodeParallel(make([]byte, BlockSize*4), 2)
+	v[blockWidth*3+5] = '-'
+
+	_, err = DecodeParallel(v, 2)
+	assert.EqualError(t, err, "Invalid character - at 134")
+
+	// Multibyte characters are reported whole
+	v = EncodeParallel(make([]byte, BlockSize*4), 2)
+	copy(v[blockWidth*2+1:], "é")
+	_, err = DecodeParallel(v, 2)
+	assert.EqualError(t, err, "Invalid character é at 87")
+
+	v = EncodeParallel(make([]byte, BlockSize*4), 2)
+	v[blockWidth*3+1] = '!'
+	_, err = DecodeParallel(v, 0)
+	var invalid ErrInvalidCharacter
+	if assert.ErrorAs(t, err, &invalid) {
+		assert.Equal(t, '!', invalid.Char)
+		assert.Equal(t, blockWidth*3+1, invalid.Offset)
+		assert.Equal(t, "", invalid.Suggestion)
+	}
+}
+
+func BenchmarkEncodeParallel(b *testing.B) {
+	src := make([]byte, 1<<20)
+	_, err := rand.Read(src)
+	require.NoError(b, err)
+
+	b.SetBytes(int64(len(src)))
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		EncodeParallel(src, 0)
+	}
+}
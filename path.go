@@ -0,0 +1,102 @@
+package base62
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EncodePath returns a URL path from a pattern using the StdEncoding,
+// see Encoding.EncodePath
+func EncodePath(pattern string, ids ...int64) (string, error) {
+	return StdEncoding.EncodePath(pattern, ids...)
+}
+
+// DecodePath decodes the IDs of a URL path matching a pattern using the
+// StdEncoding, see Encoding.DecodePath
+func DecodePath(pattern, path string) (map[string]int64, error) {
+	return StdEncoding.DecodePath(pattern, path)
+}
+
+// EncodePath returns a URL path from a pattern with the encoding, see
+// EncodePathWith
+func (e *Encoding) EncodePath(pattern string, ids ...int64) (string, error) {
+	return EncodePathWith(e, pattern, ids...)
+}
+
+// DecodePath decodes the IDs of a URL path matching a pattern with the
+// encoding, see DecodePathWith
+func (e *Encoding) DecodePath(pattern, path string) (map[string]int64, error) {
+	return DecodePathWith(e, pattern, path)
+}
+
+// EncodePathWith returns a URL path from a pattern such as
+// "/users/{user}/orders/{order}", replacing each {name} segment in turn
+// with the encoding of the next of ids by the Codec c
+func EncodePathWith(c Codec, pattern string, ids ...int64) (string, error) {
+	segments := strings.Split(pattern, "/")
+
+	n := 0
+	for i, seg := range segments {
+		if _, ok := placeholder(seg); ok {
+			if n == len(ids) {
+				return "", ErrPathMismatch{fmt.Errorf("Pattern %s has more placeholders than the %d IDs given", pattern, len(ids))}
+			}
+			segments[i] = c.EncodeInt64(ids[n])
+			n++
+		}
+	}
+	if n != len(ids) {
+		return "", ErrPathMismatch{fmt.Errorf("Pattern %s has %d placeholders for %d IDs", pattern, n, len(ids))}
+	}
+
+	return strings.Join(segments, "/"), nil
+}
+
+// DecodePathWith decodes the IDs of a URL path matching a pattern such as
+// "/users/{user}/orders/{order}" with the Codec c, returning each decoded
+// ID by the name of its placeholder. Literal segments must match exactly,
+// otherwise an ErrPathMismatch is returned
+func DecodePathWith(c Codec, pattern, path string) (map[string]int64, error) {
+	var (
+		patterns = strings.Split(pattern, "/")
+		segments = strings.Split(path, "/")
+		ids      = make(map[string]int64)
+	)
+
+	if len(patterns) != len(segments) {
+		return nil, ErrPathMismatch{fmt.Errorf("Path %s does not match pattern %s", path, pattern)}
+	}
+
+	for i, seg := range segments {
+		name, ok := placeholder(patterns[i])
+		if !ok {
+			if seg != patterns[i] {
+				return nil, ErrPathMismatch{fmt.Errorf("Path %s does not match pattern %s", path, pattern)}
+			}
+			continue
+		}
+
+		if _, dup := ids[name]; dup {
+			return nil, ErrPathMismatch{fmt.Errorf("Pattern %s repeats placeholder {%s}", pattern, name)}
+		}
+		if seg == "" {
+			return nil, ErrPathMismatch{fmt.Errorf("Path %s has an empty {%s} segment", path, name)}
+		}
+
+		n, err := c.DecodeToInt64(seg)
+		if err != nil {
+			return nil, fmt.Errorf("Path segment {%s}: %w", name, err)
+		}
+		ids[name] = n
+	}
+
+	return ids, nil
+}
+
+// placeholder returns the name of a {name} pattern segment
+func placeholder(seg string) (string, bool) {
+	if len(seg) > 2 && seg[0] == '{' && seg[len(seg)-1] == '}' {
+		return seg[1 : len(seg)-1], true
+	}
+	return "", false
+}
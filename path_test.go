@@ -0,0 +1,71 @@
+package base62
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodePath(t *testing.T) {
+	testcases := []struct {
+		pattern string
+		ids     []int64
+		path    string
+	}{
+		{"/a/{a}/b/{b}", []int64{238, 4815162342}, "/a/3q/b/5Frvgk"},
+		{"/users/{user}", []int64{61}, "/users/z"},
+		{"{id}/edit", []int64{62}, "10/edit"},
+		{"/static", nil, "/static"},
+	}
+
+	for _, tc := range testcases {
+		path, err := EncodePath(tc.pattern, tc.ids...)
+		require.NoError(t, err)
+		t.Logf("Encoded %v into %s as %s", tc.ids, tc.pattern, path)
+		assert.Equal(t, tc.path, path)
+
+		ids, err := DecodePath(tc.pattern, path)
+		require.NoError(t, err)
+		assert.Len(t, ids, len(tc.ids))
+	}
+}
+
+func TestDecodePath(t *testing.T) {
+	ids, err := DecodePath("/users/{user}/orders/{order}", "/users/3q/orders/5Frvgk")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"user": 238, "order": 4815162342}, ids)
+}
+
+func TestEncodePathInvalid(t *testing.T) {
+	_, err := EncodePath("/a/{a}/b/{b}", 1)
+	assert.IsType(t, ErrPathMismatch{}, err)
+
+	_, err = EncodePath("/a/{a}", 1, 2)
+	assert.IsType(t, ErrPathMismatch{}, err)
+}
+
+func TestDecodePathInvalid(t *testing.T) {
+	testcases := []struct {
+		pattern string
+		path    string
+	}{
+		{"/a/{a}", "/a/1/b"},
+		{"/a/{a}", "/b/1"},
+		{"/a/{a}", "/a/"},
+		{"/{a}/{a}", "/1/2"},
+	}
+
+	for _, tc := range testcases {
+		_, err := DecodePath(tc.pattern, tc.path)
+		t.Logf("Decoding %s as %s failed with %v", tc.path, tc.pattern, err)
+		assert.IsType(t, ErrPathMismatch{}, err)
+	}
+
+	_, err := DecodePath("/a/{a}", "/a/1-2")
+	assert.EqualError(t, err, "Path segment {a}: Invalid character - at 1")
+
+	var invalid ErrInvalidCharacter
+	assert.True(t, errors.As(err, &invalid))
+}
@@ -0,0 +1,45 @@
+package base62
+
+// Permutation is a reversible mapping of the non-negative int64 values
+// onto themselves, such as to obscure the order and count of sequential
+// IDs. Unpermute must be the inverse of Permute
+type Permutation interface {
+	Permute(n int64) int64
+	Unpermute(n int64) int64
+}
+
+// permuter holds the permutation of an encoding by pointer, whatever the
+// type of the permutation
+type permuter struct {
+	p Permutation
+}
+
+// Permute sets int64 values to be mapped through p before they are encoded
+// by EncodeInt64 and back after they are decoded by DecodeToInt64, so that
+// public IDs don't reveal the underlying values. Negative values are left
+// unchanged. A nil permutation removes any previously set
+func Permute(p Permutation) option {
+	return func(e *Encoding) {
+		if p == nil {
+			e.permutation = nil
+			return
+		}
+		e.permutation = &permuter{p}
+	}
+}
+
+// permute maps a non-negative value through the permutation, if any
+func (p *permuter) permute(n int64) int64 {
+	if p == nil || n < 0 {
+		return n
+	}
+	return p.p.Permute(n)
+}
+
+// unpermute reverses permute
+func (p *permuter) unpermute(n int64) int64 {
+	if p == nil || n < 0 {
+		return n
+	}
+	return p.p.Unpermute(n)
+}
@@ -0,0 +1,56 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// offsetPermutation is a trivial permutation for testing the plumbing
+type offsetPermutation struct{}
+
+func (offsetPermutation) Permute(n int64) int64   { return n ^ 1000 }
+func (offsetPermutation) Unpermute(n int64) int64 { return n ^ 1000 }
+
+func TestPermute(t *testing.T) {
+	testCases := []struct {
+		opts    []option
+		n       int64
+		encoded string
+	}{
+		{nil, 0, "G8"},
+		{nil, 1000, "0"},
+		{[]option{Sign('-')}, -5, "-5"},
+		{[]option{Padding(4)}, 1, "00G9"},
+		{[]option{Strict()}, 62, "Fq"},
+		{[]option{Luhn()}, 1000, "00"},
+	}
+
+	for _, tc := range testCases {
+		e := NewStdEncoding().Option(tc.opts...).Option(Permute(offsetPermutation{}))
+
+		s := e.EncodeInt64(tc.n)
+		t.Logf("Encoded %d as %q permuted", tc.n, s)
+		assert.Equal(t, tc.encoded, s)
+		assert.Equal(t, s, string(e.AppendInt64(nil, tc.n)))
+
+		n, err := e.DecodeToInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, tc.n, n)
+
+		n, ok := e.TryDecodeToInt64(s)
+		assert.True(t, ok)
+		assert.Equal(t, tc.n, n)
+	}
+}
+
+func TestPermuteClear(t *testing.T) {
+	e := NewStdEncoding().Option(Permute(offsetPermutation{}))
+	assert.False(t, e.Equal(NewStdEncoding()))
+	assert.Contains(t, e.String(), "permuted")
+
+	e.Option(Permute(nil))
+	assert.True(t, e.Equal(NewStdEncoding()))
+	assert.Equal(t, "G8", e.EncodeInt64(1000))
+}
@@ -0,0 +1,43 @@
+package base62
+
+// LowerEncoding orders lowercase letters before uppercase, matching
+// several other base62 libraries. It is immutable, as is StdEncoding
+var LowerEncoding = NewEncoding(encodeLower).freeze()
+
+// DigitsLastEncoding orders digits after letters, as in the base64
+// alphabet, so its zero digit is A. It is immutable
+var DigitsLastEncoding = NewEncoding(encodeDigitsLast).freeze()
+
+// LetterFirstEncoding orders digits after letters and encodes integers to
+// always start with a letter, for identifiers such as CSS IDs which must
+// not start with a digit. It is immutable
+var LetterFirstEncoding = NewEncoding(encodeDigitsLast).Option(LetterFirst()).freeze()
+
+// SortableEncoding encodes integers at a fixed width with the standard
+// alphabet, so that encodings sort in the order of their values. It is
+// immutable
+var SortableEncoding = NewStdEncoding().Option(Sortable()).freeze()
+
+// LetterFirst sets encoded integers which would start with a character
+// other than a letter to be prefixed with the zero digit, which must be a
+// letter, so that every encoding starts with a letter without changing its
+// value. Negative values still start with any Sign marker, and padding
+// with a PaddingChar precedes the letter
+func LetterFirst() option {
+	return func(e *Encoding) {
+		e.letterFirst = true
+	}
+}
+
+// leadLetter prefixes the zero digit to s if it doesn't start with a letter
+func (e *Encoding) leadLetter(s string) string {
+	if !e.letterFirst || s == "" || isLetter(s[0]) {
+		return s
+	}
+	return e.encode[:1] + s
+}
+
+// isLetter returns whether c is an ASCII letter
+func isLetter(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z'
+}
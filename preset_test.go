@@ -0,0 +1,73 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresetEncodings(t *testing.T) {
+	testCases := []struct {
+		e       *Encoding
+		n       int64
+		encoded string
+	}{
+		{LowerEncoding, 4815162342, "5fRVGK"},
+		{DigitsLastEncoding, 4815162342, "FP15qu"},
+		{DigitsLastEncoding, 0, "A"},
+		{DigitsLastEncoding, 52, "0"},
+		{LetterFirstEncoding, 4815162342, "FP15qu"},
+		{LetterFirstEncoding, 0, "A"},
+		{LetterFirstEncoding, 52, "A0"},
+		{LetterFirstEncoding, 61 * 62, "A9A"},
+	}
+
+	for _, tc := range testCases {
+		s := tc.e.EncodeInt64(tc.n)
+		t.Logf("Encoded %d as %s with %v", tc.n, s, tc.e)
+		assert.Equal(t, tc.encoded, s)
+		assert.Equal(t, s, string(tc.e.AppendInt64(nil, tc.n)))
+
+		n, err := tc.e.DecodeToInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, tc.n, n)
+	}
+
+	// Presets are immutable
+	e := LowerEncoding.Option(Padding(4))
+	assert.Equal(t, 0, LowerEncoding.Padding())
+	assert.Equal(t, "004F", e.EncodeInt64(4*62+41))
+}
+
+func TestLetterFirst(t *testing.T) {
+	testCases := []struct {
+		opts    []option
+		n       int64
+		encoded string
+	}{
+		{nil, 52, "A0"},
+		{[]option{Padding(4)}, 52, "AAA0"},
+		{[]option{Padding(4), PaddingChar('_')}, 52, "__A0"},
+		{[]option{Sign('-')}, -52, "-0"},
+		{[]option{ZigZag()}, 26, "A0"},
+		{[]option{EmptyZero()}, 0, ""},
+		{[]option{Strict()}, 52, "A0"},
+	}
+
+	for _, tc := range testCases {
+		e := NewEncoding(encodeDigitsLast).Option(LetterFirst()).Option(tc.opts...)
+
+		s := e.EncodeInt64(tc.n)
+		t.Logf("Encoded %d as %q", tc.n, s)
+		assert.Equal(t, tc.encoded, s)
+
+		n, err := e.DecodeToInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, tc.n, n)
+	}
+
+	e := LetterFirstEncoding
+	assert.Equal(t, "A0", e.EncodeUint64(52))
+	assert.Contains(t, e.String(), "letter first")
+}
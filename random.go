@@ -0,0 +1,86 @@
+package base62
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math"
+)
+
+// randomLimit is the largest multiple of our base within a byte, random
+// bytes at or above it are rejected so every digit is equally likely
+const randomLimit = 256 / base * base
+
+// maxBlockedAttempts is the number of random strings drawn before giving up
+// on finding one free of blocked words
+const maxBlockedAttempts = 100
+
+// RandomString returns a random string of length characters using the
+// StdEncoding, see Encoding.RandomString
+func RandomString(length int) (string, error) {
+	return StdEncoding.RandomString(length)
+}
+
+// GenerateToken returns a random token carrying at least bits of entropy
+// using the StdEncoding, see Encoding.GenerateToken
+func GenerateToken(bits int) (string, error) {
+	return StdEncoding.GenerateToken(bits)
+}
+
+// GenerateToken returns a random token of the minimum length carrying at
+// least bits of entropy, so tokens can be specified by their strength, such
+// as 128 bits in 22 characters, rather than by a number of characters
+func (e *Encoding) GenerateToken(bits int) (string, error) {
+	if bits < 0 {
+		return "", ErrInvalidLength{fmt.Errorf("Entropy must not be negative, got %d bits", bits)}
+	}
+
+	return e.RandomString(tokenLen(bits))
+}
+
+// tokenLen returns the number of random characters needed for bits of
+// entropy, each carrying log2(62) bits
+func tokenLen(bits int) int {
+	return int(math.Ceil(float64(bits) / math.Log2(base)))
+}
+
+// RandomString returns a string of length characters of the alphabet
+// chosen uniformly at random from crypto/rand, such as for API tokens and
+// invite codes. Random bytes are rejection sampled rather than reduced
+// modulo the base, so no characters are more likely than others. An
+// ErrInvalidConfig is returned if the Blocklist rejects every string drawn
+func (e *Encoding) RandomString(length int) (string, error) {
+	return e.randomString(rand.Reader, length)
+}
+
+// randomString returns a string of length characters chosen uniformly at
+// random from the bytes of r
+func (e *Encoding) randomString(r io.Reader, length int) (string, error) {
+	if length < 0 {
+		return "", ErrInvalidLength{fmt.Errorf("Length must not be negative, got %d", length)}
+	}
+
+	// Regenerate any strings containing blocked words
+	for attempt := 0; attempt < maxBlockedAttempts; attempt++ {
+		var (
+			out = make([]byte, 0, length)
+			buf = make([]byte, length+length/8+1)
+		)
+		for len(out) < length {
+			if _, err := io.ReadFull(r, buf); err != nil {
+				return "", err
+			}
+			for _, b := range buf {
+				if b < randomLimit && len(out) < length {
+					out = append(out, e.encode[b%base])
+				}
+			}
+		}
+
+		if s := string(out); !e.blocked(s) {
+			return s, nil
+		}
+	}
+
+	return "", ErrInvalidConfig{fmt.Errorf("Blocklist rejected %d random strings of length %d", maxBlockedAttempts, length)}
+}
@@ -0,0 +1,83 @@
+package base62
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomString(t *testing.T) {
+	for _, length := range []int{0, 1, 22, 100} {
+		s, err := RandomString(length)
+		require.NoError(t, err)
+		t.Logf("Random string of %d: %s", length, s)
+		assert.Len(t, s, length)
+
+		for _, c := range s {
+			assert.True(t, strings.ContainsRune(encodeStd, c))
+		}
+	}
+
+	_, err := RandomString(-1)
+	assert.IsType(t, ErrInvalidLength{}, err)
+}
+
+func TestRandomStringRejection(t *testing.T) {
+	// Bytes of 248 and above are rejected rather than wrapping
+	r := bytes.NewReader([]byte{248, 255, 0, 61, 62, 247, 250, 1, 2, 3, 4, 5})
+
+	s, err := StdEncoding.randomString(r, 5)
+	require.NoError(t, err)
+	assert.Equal(t, "0z0z1", s)
+
+	// Running out of randomness is an error
+	_, err = StdEncoding.randomString(bytes.NewReader([]byte{255, 255}), 1)
+	assert.Error(t, err)
+}
+
+func TestRandomStringUniform(t *testing.T) {
+	s, err := RandomString(62 * 1000)
+	require.NoError(t, err)
+
+	var counts [base]int
+	for i := 0; i < len(s); i++ {
+		counts[StdEncoding.index(s[i])]++
+	}
+
+	// Each character is expected 1000 times, allow a generous margin
+	for i, c := range counts {
+		assert.InDelta(t, 1000, c, 200, "Character %c", encodeStd[i])
+	}
+}
+
+func TestGenerateToken(t *testing.T) {
+	testcases := []struct {
+		bits   int
+		length int
+	}{
+		{0, 0},
+		{1, 1},
+		{5, 1},
+		{6, 2},
+		{64, 11},
+		{128, 22},
+		{256, 43},
+	}
+
+	for _, tc := range testcases {
+		s, err := GenerateToken(tc.bits)
+		require.NoError(t, err)
+		t.Logf("Token of %d bits: %s", tc.bits, s)
+		assert.Len(t, s, tc.length, "%d bits", tc.bits)
+
+		// The token carries at least the entropy requested
+		assert.GreaterOrEqual(t, float64(len(s))*math.Log2(base), float64(tc.bits))
+	}
+
+	_, err := GenerateToken(-1)
+	assert.IsType(t, ErrInvalidLength{}, err)
+}
@@ -0,0 +1,36 @@
+package base62
+
+import (
+	"fmt"
+)
+
+// CrockfordRemap maps the characters most often misread in transcribed
+// codes onto digits, as in Crockford's base32, for use with Remap
+const CrockfordRemap = "O0o0I1i1L1l1"
+
+// Remap sets characters outside of the alphabet to decode as characters
+// within it, so codes transcribed by people still resolve. The mapping is
+// given as pairs of characters, each input character followed by the
+// alphabet character it decodes as, such as CrockfordRemap. Pairs where the
+// input is already in the alphabet, or the target isn't, are ignored, so
+// this only applies to alphabets which leave out confusable characters. It
+// panics with an ErrInvalidConfig if pairs has an odd length
+func Remap(pairs string) option {
+	if len(pairs)%2 != 0 {
+		panic(ErrInvalidConfig{fmt.Errorf("Remap %q must be pairs of characters", pairs)})
+	}
+
+	return func(e *Encoding) {
+		// Only the pairs applied are kept, so the encoding is equal to, and
+		// has the same Spec as, one remapping just those pairs
+		var applied []byte
+		for i := 0; i < len(pairs); i += 2 {
+			from, to := pairs[i], pairs[i+1]
+			if e.decode[from] == invalidDigit && e.index(to) != -1 {
+				e.decode[from] = byte(e.index(to))
+				applied = append(applied, from, to)
+			}
+		}
+		e.remap = string(applied)
+	}
+}
@@ -0,0 +1,67 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unambiguousAlphabet leaves out the letters I, L and O in either case
+const unambiguousAlphabet = "0123456789ABCDEFGHJKMNPQRSTUVWXYZabcdefghjkmnpqrstuvwxyz!#$%&*"
+
+func TestRemap(t *testing.T) {
+	e := NewEncoding(unambiguousAlphabet).Option(Remap(CrockfordRemap))
+
+	for _, s := range []string{"10", "1O", "lo", "I0", "iO", "L0"} {
+		n, err := e.DecodeToInt64(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, int64(62), n, s)
+	}
+
+	// Encoding is unaffected
+	assert.Equal(t, "10", e.EncodeInt64(62))
+
+	_, err := NewEncoding(unambiguousAlphabet).DecodeToInt64("1O")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestRemapIgnored(t *testing.T) {
+	// O and 0 are both digits of the standard alphabet, so the pair is
+	// ignored, as is a pair without a digit to map to
+	e := NewStdEncoding().Option(Remap("O0-_"))
+
+	n, err := e.DecodeToInt64("O")
+	require.NoError(t, err)
+	assert.Equal(t, int64(24), n)
+
+	_, err = e.DecodeToInt64("1-")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+
+	// Ignored pairs are dropped, so the encoding is unchanged
+	assert.True(t, NewStdEncoding().Equal(e))
+
+	// Configs ignore the same pairs
+	c, err := NewFromConfig(Config{Remap: CrockfordRemap})
+	require.NoError(t, err)
+	assert.True(t, NewStdEncoding().Option(Remap(CrockfordRemap)).Equal(c))
+}
+
+func TestRemapInvalid(t *testing.T) {
+	assert.Panics(t, func() { Remap("O0I") })
+
+	_, err := NewFromConfig(Config{Remap: "O0I"})
+	assert.IsType(t, ErrInvalidConfig{}, err)
+}
+
+func TestRemapConfig(t *testing.T) {
+	e, err := NewFromConfig(Config{Alphabet: unambiguousAlphabet, Remap: CrockfordRemap})
+	require.NoError(t, err)
+	assert.True(t, NewEncoding(unambiguousAlphabet).Option(Remap(CrockfordRemap)).Equal(e))
+
+	spec, err := e.Spec()
+	require.NoError(t, err)
+	s, err := ParseSpec(spec)
+	require.NoError(t, err)
+	assert.True(t, e.Equal(s))
+}
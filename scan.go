@@ -0,0 +1,56 @@
+package base62
+
+import (
+	"fmt"
+)
+
+// ScanBase62Tokens is a bufio.SplitFunc which returns each run of
+// characters from the StdEncoding alphabet, see Encoding.ScanTokens
+func ScanBase62Tokens(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return StdEncoding.ScanTokens(data, atEOF)
+}
+
+// ScanTokens is a bufio.SplitFunc which returns each maximal run of
+// characters from the alphabet of the encoding, skipping any other
+// characters, so encoded values can be pulled out of mixed text streams
+func (e *Encoding) ScanTokens(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	// Skip leading characters outside of the alphabet
+	start := 0
+	for start < len(data) && !e.isAlphabet(data[start]) {
+		start++
+	}
+
+	// Scan until the end of the run of alphabet characters
+	for i := start; i < len(data); i++ {
+		if !e.isAlphabet(data[i]) {
+			return i + 1, data[start:i], nil
+		}
+	}
+
+	// A run at the end of the data may continue, unless this is the end
+	if atEOF && len(data) > start {
+		return len(data), data[start:], nil
+	}
+
+	// Request more data
+	return start, nil, nil
+}
+
+// isAlphabet returns whether c is a character of the alphabet
+func (e *Encoding) isAlphabet(c byte) bool {
+	return e.index(c) != -1
+}
+
+// scanToken reads the next space delimited token for the fmt.Scanner
+// implementations of the ID types, which accept the %v and %s verbs
+func scanToken(state fmt.ScanState, verb rune) (string, error) {
+	if verb != 'v' && verb != 's' {
+		return "", ErrInvalidValue{fmt.Errorf("Cannot scan with verb %%%c", verb)}
+	}
+
+	tok, err := state.Token(true, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(tok), nil
+}
@@ -0,0 +1,51 @@
+package base62
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanBase62Tokens(t *testing.T) {
+	input := "user=5Frvgk, order=AzL8n0Y58m7;\n\t-- [10] --"
+
+	scanner := bufio.NewScanner(strings.NewReader(input))
+	scanner.Split(ScanBase62Tokens)
+
+	var tokens []string
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Text())
+	}
+	assert.NoError(t, scanner.Err())
+	assert.Equal(t, []string{"user", "5Frvgk", "order", "AzL8n0Y58m7", "10"}, tokens)
+}
+
+func TestScanTokensSmallReads(t *testing.T) {
+	input := "  5Frvgk-AzL8n0Y58m7  z"
+
+	scanner := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(input)))
+	scanner.Split(ScanBase62Tokens)
+
+	var tokens []string
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Text())
+	}
+	assert.NoError(t, scanner.Err())
+	assert.Equal(t, []string{"5Frvgk", "AzL8n0Y58m7", "z"}, tokens)
+}
+
+func TestScanTokensAlphabet(t *testing.T) {
+	e := NewEncoding("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456-_.", Sign('~'))
+
+	scanner := bufio.NewScanner(strings.NewReader("id=a-b_c.d 789 x"))
+	scanner.Split(e.ScanTokens)
+
+	var tokens []string
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Text())
+	}
+	assert.Equal(t, []string{"id", "a-b_c.d", "x"}, tokens)
+}
@@ -0,0 +1,50 @@
+package base62
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+)
+
+// SelfTest verifies round trips of the StdEncoding, see Encoding.SelfTest
+func SelfTest() error {
+	return StdEncoding.SelfTest()
+}
+
+// SelfTest verifies that values encoded with the encoding decode back to
+// the original across edge cases: zero, int64 boundaries, padded values,
+// fixed width bytes and big integer boundaries. It is intended to be run at
+// startup by deployments which require runtime verification of their codecs
+func (e *Encoding) SelfTest() error {
+	values := []int64{0, 1, base - 1, base, base * base, 4815162342, math.MaxInt64 - 1, math.MaxInt64}
+	if e.zigzag {
+		values = append(values, -1, -base, math.MinInt64+1, math.MinInt64)
+	}
+
+	padded := e.Clone().Option(Padding(MaxLenInt64 + 4))
+	for _, enc := range []*Encoding{e, padded} {
+		for _, n := range values {
+			s := enc.EncodeInt64(n)
+			v, err := enc.DecodeToInt64(s)
+			if err != nil {
+				return ErrSelfTest{fmt.Errorf("Self test failed decoding %d encoded as %q: %w", n, s, err)}
+			}
+			if v != n {
+				return ErrSelfTest{fmt.Errorf("Self test failed, %d encoded as %q decoded as %d", n, s, v)}
+			}
+		}
+	}
+
+	for _, b := range [][]byte{make([]byte, 16), {15: 1}, bytes.Repeat([]byte{0xff}, 16), bytes.Repeat([]byte{0xa5}, 32)} {
+		s := e.encodeFixed(b)
+		v := make([]byte, len(b))
+		if err := e.decodeFixed(s, v); err != nil {
+			return ErrSelfTest{fmt.Errorf("Self test failed decoding %x encoded as %q: %w", b, s, err)}
+		}
+		if !bytes.Equal(b, v) {
+			return ErrSelfTest{fmt.Errorf("Self test failed, %x encoded as %q decoded as %x", b, s, v)}
+		}
+	}
+
+	return e.selfTestBig()
+}
@@ -0,0 +1,23 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelfTest(t *testing.T) {
+	assert.NoError(t, SelfTest())
+	assert.NoError(t, NewStdEncoding().Option(Padding(8), ZigZag()).SelfTest())
+	assert.NoError(t, NewEncoding("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789").SelfTest())
+}
+
+func TestSelfTestFailure(t *testing.T) {
+	// Characters decoding as the wrong digit don't round trip
+	e := NewStdEncoding()
+	e.decode['1'] = 0
+
+	err := e.SelfTest()
+	t.Logf("Self test failed with %v", err)
+	assert.IsType(t, ErrSelfTest{}, err)
+}
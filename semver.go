@@ -0,0 +1,77 @@
+package base62
+
+import (
+	"fmt"
+)
+
+// Field widths of an encoded semantic version, allowing major and minor
+// versions up to 3843 and patch versions up to 238327
+const (
+	semverMajorWidth = 2
+	semverMinorWidth = 2
+	semverPatchWidth = 3
+	semverWidth      = semverMajorWidth + semverMinorWidth + semverPatchWidth
+)
+
+// EncodeSemver returns the base62 encoding of a semantic version using the
+// StdEncoding, see Encoding.EncodeSemver
+func EncodeSemver(major, minor, patch int) (string, error) {
+	return StdEncoding.EncodeSemver(major, minor, patch)
+}
+
+// DecodeSemver decodes a semantic version encoded by EncodeSemver using
+// the StdEncoding
+func DecodeSemver(s string) (int, int, int, error) {
+	return StdEncoding.DecodeSemver(s)
+}
+
+// EncodeSemver returns a 7 character token of a semantic version, with 2
+// characters each for the major and minor versions and 3 for the patch.
+// The fields are fixed width, so with an alphabet in ascending byte order
+// such as the standard alphabet the tokens sort in version order
+func (e *Encoding) EncodeSemver(major, minor, patch int) (string, error) {
+	var s string
+	for _, f := range []struct {
+		name  string
+		v     int
+		width int
+	}{
+		{"Major", major, semverMajorWidth},
+		{"Minor", minor, semverMinorWidth},
+		{"Patch", patch, semverPatchWidth},
+	} {
+		if f.v < 0 {
+			return "", ErrOverflow{fmt.Errorf("%s version %d must not be negative", f.name, f.v)}
+		}
+		v := e.encodeUint64(uint64(f.v))
+		if len(v) > f.width {
+			return "", ErrOverflow{fmt.Errorf("%s version %d overflows %d characters", f.name, f.v, f.width)}
+		}
+		s += e.pad(v, f.width)
+	}
+
+	return s, nil
+}
+
+// DecodeSemver decodes a semantic version encoded by EncodeSemver,
+// returning the major, minor and patch versions
+func (e *Encoding) DecodeSemver(s string) (int, int, int, error) {
+	if len(s) != semverWidth {
+		return 0, 0, 0, ErrInvalidLength{fmt.Errorf("Encoded version must be %d characters, got %d", semverWidth, len(s))}
+	}
+
+	var v [3]int
+	for i, field := range []string{
+		s[:semverMajorWidth],
+		s[semverMajorWidth : semverMajorWidth+semverMinorWidth],
+		s[semverMajorWidth+semverMinorWidth:],
+	} {
+		n, err := e.decodeUint64(field)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		v[i] = int(n)
+	}
+
+	return v[0], v[1], v[2], nil
+}
@@ -0,0 +1,57 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeSemver(t *testing.T) {
+	testcases := []struct {
+		major, minor, patch int
+		encoded             string
+	}{
+		{0, 0, 0, "0000000"},
+		{0, 0, 1, "0000001"},
+		{1, 2, 3, "0102003"},
+		{1, 10, 62, "010A010"},
+		{3843, 3843, 238327, "zzzzzzz"},
+	}
+
+	for _, tc := range testcases {
+		s, err := EncodeSemver(tc.major, tc.minor, tc.patch)
+		require.NoError(t, err)
+		t.Logf("Encoded %d.%d.%d as %s", tc.major, tc.minor, tc.patch, s)
+		assert.Equal(t, tc.encoded, s)
+
+		major, minor, patch, err := DecodeSemver(s)
+		require.NoError(t, err)
+		assert.Equal(t, []int{tc.major, tc.minor, tc.patch}, []int{major, minor, patch})
+	}
+}
+
+func TestEncodeSemverSorts(t *testing.T) {
+	versions := [][3]int{{0, 9, 9}, {1, 0, 0}, {1, 0, 10}, {1, 2, 0}, {1, 10, 0}, {2, 0, 0}, {10, 0, 0}}
+
+	var prev string
+	for _, v := range versions {
+		s, err := EncodeSemver(v[0], v[1], v[2])
+		require.NoError(t, err)
+		assert.Less(t, prev, s)
+		prev = s
+	}
+}
+
+func TestEncodeSemverInvalid(t *testing.T) {
+	for _, v := range [][3]int{{-1, 0, 0}, {3844, 0, 0}, {0, 3844, 0}, {0, 0, 238328}} {
+		_, err := EncodeSemver(v[0], v[1], v[2])
+		assert.IsType(t, ErrOverflow{}, err)
+	}
+
+	_, _, _, err := DecodeSemver("000000")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, _, _, err = DecodeSemver("00-0000")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
@@ -0,0 +1,22 @@
+package base62
+
+import (
+	"crypto/sha256"
+)
+
+// NewShuffledEncoding returns a new Encoding with the standard alphabet
+// deterministically shuffled by key, so that services or tenants may each
+// have an encoding which is stable but incompatible with the others,
+// without writing out alphabets by hand. An empty key returns the standard
+// alphabet. This obscures values but is not encryption, as the alphabet
+// can be recovered from a few known values
+func NewShuffledEncoding(key string) *Encoding {
+	if key == "" {
+		return newEncoding(encodeStd)
+	}
+
+	// Shuffle by a digest of the key, as the consistent shuffle otherwise
+	// leaves the end of the alphabet the same for keys with a common prefix
+	sum := sha256.Sum256([]byte(key))
+	return newEncoding(shuffle(encodeStd, string(sum[:])))
+}
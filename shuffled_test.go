@@ -0,0 +1,35 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewShuffledEncoding(t *testing.T) {
+	testCases := []struct {
+		key      string
+		alphabet string
+	}{
+		{"", encodeStd},
+		{"tenant-a", "tNwAp0eIzSgVkKM82XWTJ3ydBQu4cxj6svnObf1FqoaRL7UZi5DmP9YErlHGhC"},
+		{"tenant-b", "chMKtO3NXrRwHF41Y79SjfisuGo6ybBvzUqP0pLQle2gVk85WxTmCAEadZnDIJ"},
+	}
+
+	for _, tc := range testCases {
+		e := NewShuffledEncoding(tc.key)
+		t.Logf("Shuffled by %q to %s", tc.key, e.Alphabet())
+		assert.Equal(t, tc.alphabet, e.Alphabet())
+		require.NoError(t, validateAlphabet(e.Alphabet()))
+
+		n, err := e.DecodeToInt64(e.EncodeInt64(4815162342))
+		require.NoError(t, err)
+		assert.Equal(t, int64(4815162342), n)
+	}
+
+	// Different keys are incompatible
+	a, b := NewShuffledEncoding("tenant-a"), NewShuffledEncoding("tenant-b")
+	assert.NotEqual(t, a.EncodeInt64(4815162342), b.EncodeInt64(4815162342))
+	assert.True(t, a.Equal(NewShuffledEncoding("tenant-a")))
+}
@@ -0,0 +1,70 @@
+package base62
+
+import (
+	"fmt"
+)
+
+// Sign sets the marker character of negative values, which are encoded as
+// the marker followed by the encoding of their magnitude, such as -5 as
+// "-5". Without a Sign the marker is '-', zero restores it. The marker is
+// validated as only appearing at the start when decoding, and Padding
+// includes it. It panics with an ErrInvalidConfig if the marker, or the
+// default restored, is a character of the alphabet. ZigZag takes precedence
+// for int64 values if both are set
+func Sign(marker byte) option {
+	return func(e *Encoding) {
+		e.sign = marker
+		if err := e.checkSign(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// checkSign returns an ErrInvalidConfig if the sign marker is a character
+// of the alphabet, as for alphabets including '-' without another Sign
+func (e *Encoding) checkSign() error {
+	switch {
+	case e.sign == 0 && e.index('-') != -1:
+		return ErrInvalidConfig{fmt.Errorf("Alphabet including '-' requires another Sign")}
+	case e.index(e.marker()) != -1:
+		return ErrInvalidConfig{fmt.Errorf("Sign %q is a character of the alphabet", e.marker())}
+	}
+	return nil
+}
+
+// signed returns whether negative int64 values are encoded with a sign
+// marker, which they are unless zig-zag encoded
+func (e *Encoding) signed() bool {
+	return !e.zigzag
+}
+
+// marker returns the sign marker of negative values, which is '-' unless
+// another is set by Sign
+func (e *Encoding) marker() byte {
+	if e.sign == 0 {
+		return '-'
+	}
+	return e.sign
+}
+
+// hasSign returns whether s starts with the sign marker of a negative value
+func (e *Encoding) hasSign(s string) bool {
+	return e.signed() && len(s) > 0 && s[0] == e.marker()
+}
+
+// decodeNegative decodes a string starting with the sign marker
+func (e *Encoding) decodeNegative(s string) (int64, error) {
+	if len(s) == 1 {
+		return 0, ErrInvalidLength{fmt.Errorf("Sign %c must be followed by digits", s[0])}
+	}
+
+	n, pos, overflow := e.parseUint64(s[1:])
+	if pos != -1 {
+		return 0, e.invalidCharacter(s, pos+1)
+	}
+	if overflow || n > 1<<63 {
+		return 0, ErrOverflow{fmt.Errorf("Value of %s overflows int64", s)}
+	}
+
+	return -int64(n), nil
+}
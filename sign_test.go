@@ -0,0 +1,132 @@
+package base62
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	e := NewStdEncoding().Option(Sign('-'))
+
+	testcases := []struct {
+		num     int64
+		encoded string
+	}{
+		{1, "1"},
+		{-1, "-1"},
+		{-62, "-10"},
+		{-4815162342, "-5Frvgk"},
+		{math.MaxInt64, "AzL8n0Y58m7"},
+		{-math.MaxInt64, "-AzL8n0Y58m7"},
+		{math.MinInt64, "-AzL8n0Y58m8"},
+	}
+
+	for _, tc := range testcases {
+		v := e.EncodeInt64(tc.num)
+		t.Logf("Encoded %v as %s", tc.num, v)
+		assert.Equal(t, tc.encoded, v)
+		assert.Equal(t, tc.encoded, string(e.AppendInt64(nil, tc.num)))
+
+		n, err := e.DecodeToInt64(v)
+		require.NoError(t, err)
+		assert.Equal(t, tc.num, n)
+
+		n, ok := e.TryDecodeToInt64(v)
+		assert.True(t, ok)
+		assert.Equal(t, tc.num, n)
+	}
+}
+
+func TestSignPadded(t *testing.T) {
+	e := NewStdEncoding().Option(Sign('~'), Padding(8))
+
+	assert.Equal(t, "005Frvgk", e.EncodeInt64(4815162342))
+	assert.Equal(t, "~05Frvgk", e.EncodeInt64(-4815162342))
+	assert.Equal(t, "~05Frvgk", string(e.AppendInt64(nil, -4815162342)))
+
+	n, err := e.DecodeToInt64("~05Frvgk")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-4815162342), n)
+}
+
+func TestSignInvalid(t *testing.T) {
+	e := NewStdEncoding().Option(Sign('-'))
+
+	_, err := e.DecodeToInt64("-")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = e.DecodeToInt64("5-Frvgk")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+
+	_, err = e.DecodeToInt64("--5")
+	var invalid ErrInvalidCharacter
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, 1, invalid.Offset)
+
+	_, err = e.DecodeToInt64("-AzL8n0Y58m9")
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, ok := e.TryDecodeToInt64("-")
+	assert.False(t, ok)
+
+	// Without a Sign the marker is '-', and others are invalid
+	n, err := DecodeToInt64("-5")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-5), n)
+
+	_, err = DecodeToInt64("~5")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestSignDefault(t *testing.T) {
+	testcases := []struct {
+		e       *Encoding
+		num     int64
+		encoded string
+	}{
+		{StdEncoding, -5, "-5"},
+		{StdEncoding, math.MinInt64, "-AzL8n0Y58m8"},
+		{NewStdEncoding().Option(Padding(3)), -5, "-05"},
+		{NewStdEncoding().Option(Padding(3), PaddingChar('_')), -5, "_-5"},
+		{NewStdEncoding().Option(Sign('~'), Sign(0)), -5, "-5"},
+	}
+
+	for _, tc := range testcases {
+		v := tc.e.EncodeInt64(tc.num)
+		t.Logf("Encoded %d as %q", tc.num, v)
+		assert.Equal(t, tc.encoded, v)
+		assert.Equal(t, tc.encoded, string(tc.e.AppendInt64(nil, tc.num)))
+
+		n, err := tc.e.DecodeToInt64(v)
+		require.NoError(t, err)
+		assert.Equal(t, tc.num, n)
+	}
+}
+
+func TestSignAlphabet(t *testing.T) {
+	assert.Panics(t, func() { NewStdEncoding().Option(Sign('5')) })
+	assert.Panics(t, func() { NewStdEncoding().Option(Sign('z')) })
+
+	// Alphabets including the default marker need another Sign
+	assert.Panics(t, func() { NewEncoding("-123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz") })
+	_, err := NewEncodingStrict("-123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz")
+	assert.IsType(t, ErrInvalidConfig{}, err)
+	_, err = NewFromConfig(Config{Alphabet: "-123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"})
+	assert.IsType(t, ErrInvalidConfig{}, err)
+
+	dash := NewEncoding("-123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz", Sign('~'))
+	assert.Equal(t, "-", dash.EncodeInt64(0))
+	assert.Equal(t, "~5", dash.EncodeInt64(-5))
+	assert.Equal(t, "~5", string(dash.AppendInt64(nil, -5)))
+
+	// Restoring the default marker is rejected in turn
+	assert.Panics(t, func() { dash.WithOptions(Sign(0)) })
+}
+
+func TestSignZigZag(t *testing.T) {
+	e := NewStdEncoding().Option(Sign('-'), ZigZag())
+	assert.Equal(t, "1", e.EncodeInt64(-1))
+}
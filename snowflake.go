@@ -0,0 +1,132 @@
+package base62
+
+import (
+	"fmt"
+	"time"
+)
+
+// SnowflakeLayout describes the bit layout of snowflake style IDs, which
+// hold from the most significant bit down a timestamp, a machine ID and a
+// sequence number. The timestamp takes the bits remaining below the sign
+// bit, which is unused
+type SnowflakeLayout struct {
+	// Epoch is the time of the zero timestamp
+	Epoch time.Time
+
+	// Unit is the resolution of the timestamp, a millisecond if zero
+	Unit time.Duration
+
+	// MachineBits and SequenceBits are the widths of the machine ID and
+	// sequence number
+	MachineBits  int
+	SequenceBits int
+}
+
+// TwitterSnowflake is the layout of Twitter snowflake IDs, with 10 bits of
+// machine ID and 12 bits of sequence number
+var TwitterSnowflake = SnowflakeLayout{
+	Epoch:        time.UnixMilli(1288834974657),
+	MachineBits:  10,
+	SequenceBits: 12,
+}
+
+// Snowflake holds the components of a snowflake ID
+type Snowflake struct {
+	Time     time.Time
+	Machine  uint64
+	Sequence uint64
+}
+
+// EncodeSnowflake returns the base62 encoding of a snowflake ID using the
+// StdEncoding, see Encoding.EncodeSnowflake
+func EncodeSnowflake(l SnowflakeLayout, s Snowflake) (string, error) {
+	return StdEncoding.EncodeSnowflake(l, s)
+}
+
+// DecodeSnowflake decodes a snowflake ID into its components using the
+// StdEncoding, see Encoding.DecodeSnowflake
+func DecodeSnowflake(l SnowflakeLayout, s string) (Snowflake, error) {
+	return StdEncoding.DecodeSnowflake(l, s)
+}
+
+// EncodeSnowflake composes a snowflake ID from its components in the given
+// layout and returns its encoding, as with EncodeUint64. An ErrOverflow is
+// returned if a component doesn't fit within its bits
+func (e *Encoding) EncodeSnowflake(l SnowflakeLayout, s Snowflake) (string, error) {
+	id, err := l.Compose(s)
+	if err != nil {
+		return "", err
+	}
+
+	return e.EncodeUint64(id), nil
+}
+
+// DecodeSnowflake decodes an encoded snowflake ID and splits it into its
+// components in the given layout, so logs can show when IDs were created
+func (e *Encoding) DecodeSnowflake(l SnowflakeLayout, s string) (Snowflake, error) {
+	if err := l.validate(); err != nil {
+		return Snowflake{}, err
+	}
+
+	id, err := e.DecodeToUint64(s)
+	if err != nil {
+		return Snowflake{}, err
+	}
+	if id>>63 != 0 {
+		return Snowflake{}, ErrOverflow{fmt.Errorf("Snowflake %s has the sign bit set", s)}
+	}
+
+	return l.Split(id), nil
+}
+
+// Compose returns the snowflake ID of the components, returning an
+// ErrOverflow if any doesn't fit within its bits
+func (l SnowflakeLayout) Compose(s Snowflake) (uint64, error) {
+	if err := l.validate(); err != nil {
+		return 0, err
+	}
+
+	ts := s.Time.Sub(l.Epoch) / l.unit()
+	switch {
+	case ts < 0 || uint64(ts) >= 1<<l.timeBits():
+		return 0, ErrOverflow{fmt.Errorf("Time %v is outside of the range of the snowflake layout", s.Time)}
+	case s.Machine >= 1<<l.MachineBits:
+		return 0, ErrOverflow{fmt.Errorf("Machine %d overflows %d bits", s.Machine, l.MachineBits)}
+	case s.Sequence >= 1<<l.SequenceBits:
+		return 0, ErrOverflow{fmt.Errorf("Sequence %d overflows %d bits", s.Sequence, l.SequenceBits)}
+	}
+
+	return uint64(ts)<<(l.MachineBits+l.SequenceBits) | s.Machine<<l.SequenceBits | s.Sequence, nil
+}
+
+// Split returns the components of a snowflake ID
+func (l SnowflakeLayout) Split(id uint64) Snowflake {
+	ts := id >> (l.MachineBits + l.SequenceBits)
+
+	return Snowflake{
+		Time:     l.Epoch.Add(time.Duration(ts) * l.unit()),
+		Machine:  id >> l.SequenceBits & (1<<l.MachineBits - 1),
+		Sequence: id & (1<<l.SequenceBits - 1),
+	}
+}
+
+// unit returns the resolution of the timestamp
+func (l SnowflakeLayout) unit() time.Duration {
+	if l.Unit <= 0 {
+		return time.Millisecond
+	}
+	return l.Unit
+}
+
+// timeBits returns the width of the timestamp
+func (l SnowflakeLayout) timeBits() int {
+	return 63 - l.MachineBits - l.SequenceBits
+}
+
+// validate checks the layout leaves bits for the timestamp
+func (l SnowflakeLayout) validate() error {
+	if l.MachineBits < 0 || l.SequenceBits < 0 || l.timeBits() <= 0 {
+		return ErrInvalidConfig{fmt.Errorf("Snowflake layout of %d machine and %d sequence bits leaves no timestamp", l.MachineBits, l.SequenceBits)}
+	}
+	return nil
+}
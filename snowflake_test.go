@@ -0,0 +1,66 @@
+package base62
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeSnowflake(t *testing.T) {
+	// Twitter snowflake 1541815603606036480
+	s, err := DecodeSnowflake(TwitterSnowflake, "1ptWyK4WgZU")
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1656432460105), s.Time.UnixMilli())
+	assert.Equal(t, uint64(378), s.Machine)
+	assert.Equal(t, uint64(0), s.Sequence)
+
+	v, err := EncodeSnowflake(TwitterSnowflake, s)
+	require.NoError(t, err)
+	assert.Equal(t, "1ptWyK4WgZU", v)
+}
+
+func TestSnowflakeLayout(t *testing.T) {
+	l := SnowflakeLayout{
+		Epoch:        time.Unix(1600000000, 0),
+		Unit:         10 * time.Millisecond,
+		MachineBits:  8,
+		SequenceBits: 8,
+	}
+
+	s := Snowflake{
+		Time:     time.Unix(1700000000, 120*int64(time.Millisecond)),
+		Machine:  255,
+		Sequence: 17,
+	}
+
+	id, err := l.Compose(s)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10000000012)<<16|255<<8|17, id)
+	assert.Equal(t, s.Time, l.Split(id).Time)
+	assert.Equal(t, s, l.Split(id))
+}
+
+func TestSnowflakeInvalid(t *testing.T) {
+	now := time.Now()
+
+	for _, s := range []Snowflake{
+		{Time: TwitterSnowflake.Epoch.Add(-time.Second)},
+		{Time: TwitterSnowflake.Epoch.Add(1 << 41 * time.Millisecond)},
+		{Time: now, Machine: 1024},
+		{Time: now, Sequence: 4096},
+	} {
+		_, err := EncodeSnowflake(TwitterSnowflake, s)
+		t.Logf("Snowflake %+v failed with %v", s, err)
+		assert.IsType(t, ErrOverflow{}, err)
+	}
+
+	_, err := EncodeSnowflake(SnowflakeLayout{MachineBits: 32, SequenceBits: 31}, Snowflake{})
+	assert.IsType(t, ErrInvalidConfig{}, err)
+
+	// The sign bit is never set
+	_, err = DecodeSnowflake(TwitterSnowflake, EncodeUint64(1<<63))
+	assert.IsType(t, ErrOverflow{}, err)
+}
@@ -0,0 +1,39 @@
+package base62
+
+import (
+	"math"
+)
+
+// Sortable sets integers to be encoded at the fixed maximum width of
+// MaxLenInt64 characters, with int64 values offset by 2^63 so negative
+// values precede positive ones. With an alphabet in byte order, such as
+// the standard alphabet, encoded strings then sort in the same order as
+// their values, as in database indexes or object store key listings.
+// Sortable takes precedence over Padding, Sign and ZigZag
+func Sortable() option {
+	return func(e *Encoding) {
+		e.sortable = true
+	}
+}
+
+// encodeSortable returns the fixed width encoding of an offset value
+func (e *Encoding) encodeSortable(u uint64) string {
+	return e.format(e.luhnAppend(e.pad(e.encodeUint64(u), MaxLenInt64)))
+}
+
+// sortableOffset converts between int64 values and their unsigned offset,
+// in either direction, by flipping the sign bit
+func sortableOffset(u uint64) uint64 {
+	return u ^ (math.MaxInt64 + 1)
+}
+
+// byteOrdered returns whether the characters of an alphabet are in
+// ascending byte order, so encodings sort as their values
+func byteOrdered(alphabet string) bool {
+	for i := 1; i < len(alphabet); i++ {
+		if alphabet[i] <= alphabet[i-1] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package base62
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortable(t *testing.T) {
+	testCases := []struct {
+		n       int64
+		encoded string
+	}{
+		{math.MinInt64, "00000000000"},
+		{-1, "AzL8n0Y58m7"},
+		{0, "AzL8n0Y58m8"},
+		{1, "AzL8n0Y58m9"},
+		{math.MaxInt64, "LygHa16AHYF"},
+	}
+
+	for _, tc := range testCases {
+		s := SortableEncoding.EncodeInt64(tc.n)
+		t.Logf("Encoded %d as %s", tc.n, s)
+		assert.Equal(t, tc.encoded, s)
+		assert.Equal(t, s, string(SortableEncoding.AppendInt64(nil, tc.n)))
+
+		n, err := SortableEncoding.DecodeToInt64(s)
+		require.NoError(t, err)
+		assert.Equal(t, tc.n, n)
+
+		n, ok := SortableEncoding.TryDecodeToInt64(s)
+		assert.True(t, ok)
+		assert.Equal(t, tc.n, n)
+	}
+
+	assert.Equal(t, "00000000000", SortableEncoding.EncodeUint64(0))
+	assert.Equal(t, "LygHa16AHYF", SortableEncoding.EncodeUint64(math.MaxUint64))
+}
+
+func TestSortableOrder(t *testing.T) {
+	values := []int64{math.MinInt64, -1 << 40, -62, -61, -1, 0, 1, 61, 62, 3843, 3844, 1 << 40, math.MaxInt64}
+
+	var encoded []string
+	for _, n := range values {
+		encoded = append(encoded, SortableEncoding.EncodeInt64(n))
+	}
+	assert.True(t, slices.IsSorted(encoded), "%v", encoded)
+
+	// Sign and ZigZag don't change the encoding
+	e := NewStdEncoding().Option(Sortable(), Sign('-'), ZigZag(), Padding(4))
+	for i, n := range values {
+		assert.Equal(t, encoded[i], e.EncodeInt64(n))
+	}
+
+	unsigned := []uint64{0, 1, 62, 1 << 63, math.MaxUint64}
+	encoded = encoded[:0]
+	for _, n := range unsigned {
+		encoded = append(encoded, SortableEncoding.EncodeUint64(n))
+	}
+	assert.True(t, slices.IsSorted(encoded), "%v", encoded)
+}
+
+func TestByteOrdered(t *testing.T) {
+	assert.True(t, byteOrdered(encodeStd))
+	assert.False(t, byteOrdered(encodeLower))
+	assert.False(t, byteOrdered(encodeDigitsLast))
+}
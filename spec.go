@@ -0,0 +1,243 @@
+package base62
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// presets are the named alphabets which may be used in specs
+var presets = map[string]string{
+	"std":        encodeStd,
+	"lower":      encodeLower,
+	"digitslast": encodeDigitsLast,
+}
+
+// specEscaper escapes the characters of spec values which would otherwise
+// separate options or their keys and values
+var specEscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `=`, `\=`)
+
+// listEscaper escapes the characters of list items which would otherwise
+// separate them, before the list as a whole is escaped by specEscaper
+var listEscaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`)
+
+// ParseSpec returns a new Encoding described by a compact spec string, such
+// as "std;pad=8;zigzag". The spec starts with either the name of a preset
+// alphabet or a literal 62 character alphabet, followed by any options
+// separated by semicolons. Semicolons, equals signs and backslashes within
+// option values are escaped by a backslash:
+//
+//	pad=N   sets the Padding to N
+//	zigzag  sets ZigZag encoding
+//	le      sets a little-endian ByteOrder
+//	group=N:SEP  sets a Group of N characters separated by SEP
+//	ignore=SEPS  sets IgnoreSeparators to the characters of SEPS
+//	lenient  sets Lenient decoding
+//	sign=C  sets the Sign marker to C
+//	emptyzero  sets EmptyZero encoding
+//	padchar=C  sets the PaddingChar to C
+//	align=A  sets the Alignment to left or right
+//	maxlen=N  sets the MaxDecodeLen to N
+//	luhn  sets Luhn check characters
+//	strict  sets Strict decoding
+//	nocase  sets CaseInsensitive decoding
+//	remap=PAIRS  sets Remap to the pairs of characters of PAIRS
+//	blocklist=WORDS  sets the Blocklist to the comma separated WORDS,
+//	  within which commas and backslashes are escaped again by a backslash
+//	mask=N  sets WithMask to N, in decimal or 0x prefixed hexadecimal
+//	letterfirst  sets LetterFirst encoding
+//	sortable  sets Sortable encoding
+//	knuth  sets KnuthHash
+func ParseSpec(spec string) (*Encoding, error) {
+	var c Config
+
+	// Read the alphabet, a literal alphabet may itself contain semicolons
+	// so is identified by length rather than by splitting
+	name, rest, _ := strings.Cut(spec, ";")
+	if alphabet, ok := presets[name]; ok {
+		c.Alphabet = alphabet
+	} else if len(spec) >= base && (len(spec) == base || spec[base] == ';') {
+		c.Alphabet, rest = spec[:base], strings.TrimPrefix(spec[base:], ";")
+	} else {
+		return nil, ErrInvalidConfig{fmt.Errorf("Spec %q must start with a preset name or %d character alphabet", spec, base)}
+	}
+
+	if rest != "" {
+		for _, opt := range splitEscaped(rest, ';') {
+			key, value, hasValue := strings.Cut(opt, "=")
+			switch {
+			case key == "pad" && hasValue:
+				n, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, ErrInvalidConfig{fmt.Errorf("Spec padding %q is not a number", value)}
+				}
+				c.Padding = n
+			case key == "zigzag" && !hasValue:
+				c.ZigZag = true
+			case key == "le" && !hasValue:
+				c.LittleEndian = true
+			case key == "group" && hasValue:
+				size, sep, _ := strings.Cut(value, ":")
+				n, err := strconv.Atoi(size)
+				if err != nil {
+					return nil, ErrInvalidConfig{fmt.Errorf("Spec group %q is not a number", size)}
+				}
+				c.Group, c.GroupSeparator = n, sep
+			case key == "ignore" && hasValue:
+				c.IgnoreSeparators = value
+			case key == "lenient" && !hasValue:
+				c.Lenient = true
+			case key == "sign" && hasValue:
+				c.Sign = value
+			case key == "emptyzero" && !hasValue:
+				c.EmptyZero = true
+			case key == "padchar" && hasValue:
+				c.PaddingChar = value
+			case key == "align" && hasValue:
+				c.Align = value
+			case key == "maxlen" && hasValue:
+				n, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, ErrInvalidConfig{fmt.Errorf("Spec maximum length %q is not a number", value)}
+				}
+				c.MaxDecodeLen = n
+			case key == "luhn" && !hasValue:
+				c.Luhn = true
+			case key == "strict" && !hasValue:
+				c.Strict = true
+			case key == "nocase" && !hasValue:
+				c.CaseInsensitive = true
+			case key == "remap" && hasValue:
+				c.Remap = value
+			case key == "blocklist" && hasValue:
+				c.Blocklist = splitEscaped(value, ',')
+			case key == "mask" && hasValue:
+				n, err := strconv.ParseUint(value, 0, 64)
+				if err != nil {
+					return nil, ErrInvalidConfig{fmt.Errorf("Spec mask %q is not a number", value)}
+				}
+				c.Mask = n
+			case key == "letterfirst" && !hasValue:
+				c.LetterFirst = true
+			case key == "sortable" && !hasValue:
+				c.Sortable = true
+			case key == "knuth" && !hasValue:
+				c.KnuthHash = true
+			default:
+				return nil, ErrInvalidConfig{fmt.Errorf("Spec option %q is not recognised", opt)}
+			}
+		}
+	}
+
+	return NewFromConfig(c)
+}
+
+// Spec returns the compact spec string describing the encoding, which
+// ParseSpec will parse back to an identical Encoding. Transforms and any
+// Permutation other than KnuthHash can't be described by a spec, for which
+// an ErrInvalidConfig is returned
+func (e *Encoding) Spec() (string, error) {
+	if e.transforms != nil {
+		return "", ErrInvalidConfig{fmt.Errorf("Spec can't describe Transforms")}
+	}
+	if e.permutation != nil && e.permutation != knuthPermuter {
+		return "", ErrInvalidConfig{fmt.Errorf("Spec can't describe a Permutation other than KnuthHash")}
+	}
+
+	parts := []string{e.encode}
+	for name, alphabet := range presets {
+		if alphabet == e.encode {
+			parts[0] = name
+		}
+	}
+
+	if e.padding > 0 {
+		parts = append(parts, "pad="+strconv.Itoa(e.padding))
+	}
+	if e.zigzag {
+		parts = append(parts, "zigzag")
+	}
+	if e.littleEndian {
+		parts = append(parts, "le")
+	}
+	if e.groupSize > 0 {
+		parts = append(parts, "group="+strconv.Itoa(e.groupSize)+":"+specEscaper.Replace(e.groupSep))
+	}
+	if e.ignore != "" {
+		parts = append(parts, "ignore="+specEscaper.Replace(e.ignore))
+	}
+	if e.lenient {
+		parts = append(parts, "lenient")
+	}
+	if e.sign != 0 {
+		parts = append(parts, "sign="+specEscaper.Replace(string(e.sign)))
+	}
+	if e.emptyZero {
+		parts = append(parts, "emptyzero")
+	}
+	if e.padChar != 0 {
+		parts = append(parts, "padchar="+specEscaper.Replace(string(e.padChar)))
+	}
+	if e.align != AlignRight {
+		parts = append(parts, "align="+e.align.String())
+	}
+	if e.maxDecodeLen > 0 {
+		parts = append(parts, "maxlen="+strconv.Itoa(e.maxDecodeLen))
+	}
+	if e.luhn {
+		parts = append(parts, "luhn")
+	}
+	if e.strict {
+		parts = append(parts, "strict")
+	}
+	if e.caseInsensitive {
+		parts = append(parts, "nocase")
+	}
+	if e.remap != "" {
+		parts = append(parts, "remap="+specEscaper.Replace(e.remap))
+	}
+	if e.blocklist != "" {
+		words := strings.Split(e.blocklist, blocklistSep)
+		for i, w := range words {
+			words[i] = listEscaper.Replace(w)
+		}
+		parts = append(parts, "blocklist="+specEscaper.Replace(strings.Join(words, ",")))
+	}
+	if e.mask != 0 {
+		parts = append(parts, "mask="+strconv.FormatUint(e.mask, 10))
+	}
+	if e.letterFirst {
+		parts = append(parts, "letterfirst")
+	}
+	if e.sortable {
+		parts = append(parts, "sortable")
+	}
+	if e.permutation != nil {
+		parts = append(parts, "knuth")
+	}
+
+	return strings.Join(parts, ";"), nil
+}
+
+// splitEscaped splits the options of a spec, or items of a list, at each
+// sep which isn't escaped, removing the escapes
+func splitEscaped(s string, sep byte) []string {
+	var (
+		opts []string
+		b    strings.Builder
+	)
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case s[i] == sep:
+			opts = append(opts, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return append(opts, b.String())
+}
@@ -0,0 +1,168 @@
+package base62
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpec(t *testing.T) {
+	testcases := []struct {
+		spec     string
+		encoding *Encoding
+	}{
+		{"std", StdEncoding},
+		{"std;pad=8", NewStdEncoding().Option(Padding(8))},
+		{"std;pad=8;zigzag", NewStdEncoding().Option(Padding(8), ZigZag())},
+		{"std;zigzag;pad=8", NewStdEncoding().Option(Padding(8), ZigZag())},
+		{"std;group=4:-", NewStdEncoding().Option(Group(4, "-"))},
+		{"std;ignore=- ", NewStdEncoding().Option(IgnoreSeparators("- "))},
+		{"std;lenient", NewStdEncoding().Option(Lenient())},
+		{"std;sign=-", NewStdEncoding().Option(Sign('-'))},
+		{"std;emptyzero", NewStdEncoding().Option(EmptyZero())},
+		{"std;pad=8;padchar=_", NewStdEncoding().Option(Padding(8), PaddingChar('_'))},
+		{"std;maxlen=32", NewStdEncoding().Option(MaxDecodeLen(32))},
+		{"std;luhn", NewStdEncoding().Option(Luhn())},
+		{"std;strict", NewStdEncoding().Option(Strict())},
+		{"std;nocase", NewStdEncoding().Option(CaseInsensitive())},
+		{"std;blocklist=foo,bar", NewStdEncoding().Option(Blocklist("foo", "bar"))},
+		{"std;mask=0xff", NewStdEncoding().Option(WithMask(0xff))},
+		{"std;mask=255", NewStdEncoding().Option(WithMask(0xff))},
+		{"digitslast;letterfirst", LetterFirstEncoding},
+		{"std;sortable", SortableEncoding},
+		{"std;pad=8;padchar= ;align=left", NewStdEncoding().Option(Padding(8), PaddingChar(' '), Align(AlignLeft))},
+		{"std;knuth", NewStdEncoding().Option(KnuthHash())},
+		{`std;pad=8;padchar=\;`, NewStdEncoding().Option(Padding(8), PaddingChar(';'))},
+		{"std;pad=8;padchar=:", NewStdEncoding().Option(Padding(8), PaddingChar(':'))},
+		{`std;pad=8;padchar=\\`, NewStdEncoding().Option(Padding(8), PaddingChar('\\'))},
+		{`std;group=4:\;`, NewStdEncoding().Option(Group(4, ";"))},
+		{"std;group=4::", NewStdEncoding().Option(Group(4, ":"))},
+		{`std;ignore=\;:;sign=~`, NewStdEncoding().Option(IgnoreSeparators(";:"), Sign('~'))},
+		{`std;sign=\;`, NewStdEncoding().Option(Sign(';'))},
+		{`std;remap=\;0`, NewStdEncoding().Option(Remap(";0"))},
+		{
+			"abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789;pad=2",
+			NewEncoding("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789").Option(Padding(2)),
+		},
+		{
+			"abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ;=+-*/!?.,;sign=~",
+			NewEncoding("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ;=+-*/!?.,", Sign('~')),
+		},
+	}
+
+	for _, tc := range testcases {
+		e, err := ParseSpec(tc.spec)
+		require.NoError(t, err, tc.spec)
+		assert.True(t, tc.encoding.Equal(e), tc.spec)
+
+		// Round trip back through the spec
+		spec, err := e.Spec()
+		require.NoError(t, err)
+		e, err = ParseSpec(spec)
+		require.NoError(t, err, spec)
+		assert.True(t, tc.encoding.Equal(e), tc.spec)
+	}
+}
+
+func TestSpec(t *testing.T) {
+	testcases := []struct {
+		encoding *Encoding
+		spec     string
+	}{
+		{StdEncoding, "std"},
+		{NewStdEncoding().Option(Padding(8), ZigZag()), "std;pad=8;zigzag"},
+		{
+			NewEncoding("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"),
+			"abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789",
+		},
+		{NewStdEncoding().Option(Padding(8), PaddingChar(';')), `std;pad=8;padchar=\;`},
+		{NewStdEncoding().Option(Group(4, `\;`)), `std;group=4:\\\;`},
+		{NewStdEncoding().Option(KnuthHash(), WithMask(0xff)), "std;mask=255;knuth"},
+	}
+
+	for _, tc := range testcases {
+		spec, err := tc.encoding.Spec()
+		require.NoError(t, err)
+		assert.Equal(t, tc.spec, spec)
+	}
+}
+
+func TestSpecRoundTrip(t *testing.T) {
+	encodings := []*Encoding{
+		NewStdEncoding().Option(Padding(8)),
+		NewStdEncoding().Option(ZigZag()),
+		NewStdEncoding().Option(ByteOrder(binary.LittleEndian)),
+		NewStdEncoding().Option(Group(4, ";=,")),
+		NewStdEncoding().Option(IgnoreSeparators(`-;=\`)),
+		NewStdEncoding().Option(Lenient()),
+		NewStdEncoding().Option(Sign('=')),
+		NewStdEncoding().Option(EmptyZero()),
+		NewStdEncoding().Option(Padding(8), PaddingChar('=')),
+		NewStdEncoding().Option(Padding(8), PaddingChar(' '), Align(AlignLeft)),
+		NewStdEncoding().Option(MaxDecodeLen(32)),
+		NewStdEncoding().Option(Luhn()),
+		NewStdEncoding().Option(Strict()),
+		NewStdEncoding().Option(CaseInsensitive()),
+		NewStdEncoding().Option(Remap(CrockfordRemap)),
+		NewStdEncoding().Option(Remap(";0=1,2")),
+		NewEncoding(unambiguousAlphabet).Option(Remap(CrockfordRemap)),
+		NewStdEncoding().Option(Blocklist(DefaultBlocklist...)),
+		NewStdEncoding().Option(Blocklist("a;b", "c=d", "e,f", `g\,h`)),
+		NewStdEncoding().Option(WithMask(0xff)),
+		LetterFirstEncoding,
+		SortableEncoding,
+		NewStdEncoding().Option(KnuthHash()),
+	}
+
+	for _, e := range encodings {
+		spec, err := e.Spec()
+		require.NoError(t, err)
+		t.Logf("Spec of %v is %q", e, spec)
+
+		parsed, err := ParseSpec(spec)
+		require.NoError(t, err, spec)
+		assert.True(t, e.Equal(parsed), spec)
+
+		again, err := parsed.Spec()
+		require.NoError(t, err)
+		assert.Equal(t, spec, again)
+	}
+}
+
+func TestSpecInvalid(t *testing.T) {
+	encodings := []*Encoding{
+		NewStdEncoding().Option(Permute(NewFeistel([]byte("key")))),
+		NewStdEncoding().Option(Transforms(XORKey([]byte("key")))),
+	}
+
+	for _, e := range encodings {
+		_, err := e.Spec()
+		t.Logf("Spec of %v failed with %v", e, err)
+		assert.IsType(t, ErrInvalidConfig{}, err)
+	}
+}
+
+func TestParseSpecInvalid(t *testing.T) {
+	testcases := []string{
+		"",
+		"nonstd",
+		"std;pad",
+		"std;pad=x",
+		"std;pad=-1",
+		"std;zigzag=1",
+		"std;unknown",
+		"std;mask=-1",
+		"std;letterfirst",
+		"lower;sortable",
+		"0023456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz",
+		"0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz!",
+	}
+
+	for _, spec := range testcases {
+		_, err := ParseSpec(spec)
+		t.Logf("Spec %q failed with %v", spec, err)
+		assert.IsType(t, ErrInvalidConfig{}, err)
+	}
+}
@@ -0,0 +1,60 @@
+//go:build !base62_nobig
+
+package base62
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+/**
+ * Database support, which is excluded along with big integers by the
+ * base62_nobig build tag, as database/sql/driver depends on math/big
+ */
+
+// Value implements driver.Valuer, so an Int62 is stored in the database
+// as an integer column while presented in Go as its base62 encoding. An
+// Int62 is not an sql.Scanner, as its Scan method is that of fmt.Scanner,
+// but database/sql scans integer columns into it as into any integer type.
+// NullInt62 is the sql.Scanner for nullable columns
+func (n Int62) Value() (driver.Value, error) {
+	return int64(n), nil
+}
+
+// Value implements driver.Valuer
+func (n NullInt62) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Int62.Value()
+}
+
+// Scan implements sql.Scanner, reading an integer column, including the
+// decimal text which some drivers return for integers
+func (n *NullInt62) Scan(src any) error {
+	n.Int62, n.Valid = 0, false
+
+	var text string
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case int64:
+		n.Int62, n.Valid = Int62(v), true
+		return nil
+	case []byte:
+		text = string(v)
+	case string:
+		text = v
+	default:
+		return ErrInvalidValue{fmt.Errorf("Cannot scan %T into NullInt62", src)}
+	}
+
+	v, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return ErrInvalidValue{fmt.Errorf("Cannot scan %q into NullInt62: %w", text, err)}
+	}
+
+	n.Int62, n.Valid = Int62(v), true
+	return nil
+}
@@ -0,0 +1,90 @@
+//go:build !base62_nobig
+
+package base62
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ driver.Valuer = Int62(0)
+	_ driver.Valuer = NullInt62{}
+	_ sql.Scanner   = (*NullInt62)(nil)
+)
+
+func TestInt62Value(t *testing.T) {
+	v, err := Int62(4815162342).Value()
+	require.NoError(t, err)
+	assert.Equal(t, driver.Value(int64(4815162342)), v)
+
+	// Values are valid driver values, as checked by database/sql
+	v, err = driver.DefaultParameterConverter.ConvertValue(Int62(-1))
+	require.NoError(t, err)
+	assert.Equal(t, driver.Value(int64(-1)), v)
+}
+
+func TestInt62Scan(t *testing.T) {
+	// database/sql scans integer columns into an Int62 by its kind
+	for _, src := range []any{int64(4815162342), []byte("4815162342"), "4815162342"} {
+		var n sql.Null[Int62]
+		require.NoError(t, n.Scan(src))
+		assert.True(t, n.Valid)
+		assert.Equal(t, "5Frvgk", n.V.String())
+	}
+}
+
+func TestNullInt62Scan(t *testing.T) {
+	testCases := []struct {
+		src   any
+		n     Int62
+		valid bool
+		ok    bool
+	}{
+		{int64(4815162342), 4815162342, true, true},
+		{[]byte("4815162342"), 4815162342, true, true},
+		{"-1", -1, true, true},
+		{nil, 0, false, true},
+		{"5Frvgk", 0, false, false},
+		{1.5, 0, false, false},
+	}
+
+	for _, tc := range testCases {
+		n := NullInt62{Int62: 1, Valid: true}
+		err := n.Scan(tc.src)
+		t.Logf("Scanned %#v as %v: %v", tc.src, n, err)
+		if !tc.ok {
+			assert.IsType(t, ErrInvalidValue{}, err)
+		} else {
+			require.NoError(t, err)
+		}
+		assert.Equal(t, NullInt62{tc.n, tc.valid}, n)
+	}
+}
+
+func TestNullInt62(t *testing.T) {
+	var n NullInt62
+	assert.True(t, n.IsZero())
+
+	v, err := n.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	require.NoError(t, n.Scan(int64(62)))
+	assert.Equal(t, NullInt62{62, true}, n)
+	assert.False(t, n.IsZero())
+
+	v, err = n.Value()
+	require.NoError(t, err)
+	assert.Equal(t, driver.Value(int64(62)), v)
+
+	require.NoError(t, n.Scan(nil))
+	assert.Equal(t, NullInt62{}, n)
+
+	assert.Error(t, n.Scan(1.5))
+	assert.False(t, n.Valid)
+}
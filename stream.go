@@ -0,0 +1,180 @@
+package base62
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// encoder is the streaming encoder returned by NewEncoder
+type encoder struct {
+	e   *Encoding
+	w   io.Writer
+	err error
+
+	// buf holds a partial block awaiting further input
+	buf  [BlockSize]byte
+	nbuf int
+	out  []byte
+}
+
+// NewEncoder returns a stream encoder, which base62 encodes data written to
+// it and writes the encoding to w. Data is encoded in blocks of BlockSize
+// bytes, in the same format as EncodeToString and EncodeParallel, so memory
+// use is bounded however large the input. The final partial block is
+// written on Close, which must be called once all data has been written.
+// Transforms apply to whole values so can't be streamed, and writes to an
+// encoding with Transforms return an ErrInvalidConfig
+func NewEncoder(e *Encoding, w io.Writer) io.WriteCloser {
+	return &encoder{e: e, w: w, err: e.streamable()}
+}
+
+// streamable returns an ErrInvalidConfig if the encoding has Transforms,
+// which can't be applied to a stream
+func (e *Encoding) streamable() error {
+	if e.transforms != nil {
+		return ErrInvalidConfig{fmt.Errorf("Transforms apply to whole values and can't be streamed")}
+	}
+	return nil
+}
+
+// Write encodes each full block of p, buffering any remainder
+func (enc *encoder) Write(p []byte) (int, error) {
+	if enc.err != nil {
+		return 0, enc.err
+	}
+
+	var n int
+
+	// Complete any partially buffered block first
+	if enc.nbuf > 0 {
+		c := copy(enc.buf[enc.nbuf:], p)
+		enc.nbuf += c
+		n += c
+		p = p[c:]
+		if enc.nbuf < BlockSize {
+			return n, nil
+		}
+		if enc.err = enc.flush(enc.buf[:]); enc.err != nil {
+			return n, enc.err
+		}
+		enc.nbuf = 0
+	}
+
+	for len(p) >= BlockSize {
+		if enc.err = enc.flush(p[:BlockSize]); enc.err != nil {
+			return n, enc.err
+		}
+		n += BlockSize
+		p = p[BlockSize:]
+	}
+
+	enc.nbuf = copy(enc.buf[:], p)
+	return n + enc.nbuf, nil
+}
+
+// Close encodes and writes the final partial block
+func (enc *encoder) Close() error {
+	if enc.err != nil {
+		return enc.err
+	}
+
+	enc.err = enc.flush(enc.buf[:enc.nbuf])
+	enc.nbuf = 0
+	return enc.err
+}
+
+// flush encodes a block and writes it to the underlying writer
+func (enc *encoder) flush(b []byte) error {
+	if enc.out == nil {
+		enc.out = make([]byte, blockWidth)
+	}
+
+	out := enc.out[:EncodedLen(len(b))]
+	enc.e.putBlocks(out, b)
+
+	_, err := enc.w.Write(out)
+	return err
+}
+
+// decoder is the streaming decoder returned by NewDecoder
+type decoder struct {
+	e   *Encoding
+	r   io.Reader
+	err error
+
+	// in holds encoded input not yet decoded, at stream offset off
+	in  []byte
+	off int
+
+	// out holds decoded bytes not yet read
+	out  []byte
+	obuf [BlockSize]byte
+}
+
+// NewDecoder returns a stream decoder, which decodes base62 data read from r
+// in the block format of NewEncoder, EncodeParallel and EncodeToString,
+// holding at most a block in memory. Invalid characters are reported as an
+// ErrInvalidCharacter with their offset in the stream, and reads from an
+// encoding with Transforms return an ErrInvalidConfig
+func NewDecoder(e *Encoding, r io.Reader) io.Reader {
+	return &decoder{e: e, r: r, in: make([]byte, 0, blockWidth+1), err: e.streamable()}
+}
+
+// Read decodes blocks as needed to fill p
+func (dec *decoder) Read(p []byte) (int, error) {
+	for len(dec.out) == 0 {
+		if dec.err != nil {
+			return 0, dec.err
+		}
+		dec.err = dec.decode()
+	}
+
+	n := copy(p, dec.out)
+	dec.out = dec.out[n:]
+	return n, nil
+}
+
+// decode decodes the next block into out, returning io.EOF after the final
+// block. A block is only known to be the final partial block once the end
+// of the input is reached, so a character beyond each full block is read
+func (dec *decoder) decode() error {
+	var err error
+	for len(dec.in) <= blockWidth && err == nil {
+		var n int
+		n, err = dec.r.Read(dec.in[len(dec.in):cap(dec.in)])
+		dec.in = dec.in[:len(dec.in)+n]
+	}
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	w := min(len(dec.in), blockWidth)
+	if w == 0 {
+		return io.EOF
+	}
+
+	n, ok := bytesLen(w)
+	if !ok {
+		return ErrInvalidLength{fmt.Errorf("Encoded length %d is not a valid length of blocks", dec.off+w)}
+	}
+
+	if err := dec.e.decodeBlocks(dec.obuf[:n], string(dec.in[:w])); err != nil {
+		// Report invalid characters at their offset in the whole stream
+		var invalid ErrInvalidCharacter
+		if errors.As(err, &invalid) {
+			invalid.Offset += dec.off
+			invalid.Suggestion = ""
+			invalid.error = fmt.Errorf("Invalid character %c at %d", invalid.Char, invalid.Offset)
+			return invalid
+		}
+		return err
+	}
+	dec.out = dec.obuf[:n]
+
+	// Shift any read ahead input down for the next block
+	dec.in = dec.in[:copy(dec.in, dec.in[w:])]
+	dec.off += w
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package base62
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEncoder(t *testing.T) {
+	for _, size := range []int{0, 1, 31, 32, 33, 64, 1000, 100000} {
+		src := make([]byte, size)
+		_, err := rand.Read(src)
+		require.NoError(t, err)
+
+		// Write in uneven chunks to exercise buffering across blocks
+		for _, chunk := range []int{1, 7, 32, 1000} {
+			var buf bytes.Buffer
+			enc := NewEncoder(StdEncoding, &buf)
+			for p := src; len(p) > 0; {
+				n, err := enc.Write(p[:min(chunk, len(p))])
+				require.NoError(t, err)
+				p = p[n:]
+			}
+			require.NoError(t, enc.Close())
+
+			assert.Equal(t, string(EncodeParallel(src, 1)), buf.String(), "size %d chunk %d", size, chunk)
+		}
+	}
+}
+
+// errWriter fails every write
+type errWriter struct{}
+
+func (errWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestNewEncoderError(t *testing.T) {
+	enc := NewEncoder(StdEncoding, errWriter{})
+
+	_, err := enc.Write(make([]byte, BlockSize))
+	assert.EqualError(t, err, "write failed")
+
+	// Errors persist
+	_, err = enc.Write([]byte{1})
+	assert.EqualError(t, err, "write failed")
+	assert.EqualError(t, enc.Close(), "write failed")
+}
+
+func TestNewDecoder(t *testing.T) {
+	for _, size := range []int{0, 1, 31, 32, 33, 64, 1000, 100000} {
+		src := make([]byte, size)
+		_, err := rand.Read(src)
+		require.NoError(t, err)
+		encoded := EncodeParallel(src, 1)
+
+		// Read through a reader returning a byte at a time, to exercise
+		// reading ahead across blocks
+		for _, r := range []io.Reader{bytes.NewReader(encoded), iotest.OneByteReader(bytes.NewReader(encoded))} {
+			decoded, err := io.ReadAll(NewDecoder(StdEncoding, r))
+			require.NoError(t, err)
+			assert.True(t, bytes.Equal(src, decoded), "size %d", size)
+		}
+	}
+}
+
+func TestNewDecoderInvalid(t *testing.T) {
+	encoded := EncodeParallel(make([]byte, BlockSize*2+4), 1)
+
+	invalid := bytes.Clone(encoded)
+	invalid[blockWidth+5] = '-'
+	_, err := io.ReadAll(NewDecoder(StdEncoding, bytes.NewReader(invalid)))
+	var ic ErrInvalidCharacter
+	require.ErrorAs(t, err, &ic)
+	assert.Equal(t, blockWidth+5, ic.Offset)
+	assert.EqualError(t, err, fmt.Sprintf("Invalid character - at %d", blockWidth+5))
+
+	_, err = io.ReadAll(NewDecoder(StdEncoding, bytes.NewReader(encoded[:len(encoded)-2])))
+	assert.IsType(t, ErrInvalidLength{}, err)
+}
+
+func TestNewEncoderDecodeString(t *testing.T) {
+	for _, size := range []int{33, 40, 64, 1000, 100000} {
+		src := make([]byte, size)
+		_, err := rand.Read(src)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		enc := NewEncoder(StdEncoding, &buf)
+		_, err = enc.Write(src)
+		require.NoError(t, err)
+		require.NoError(t, enc.Close())
+
+		decoded, err := DecodeString(buf.String())
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(src, decoded), "size %d", size)
+
+		decoded, err = io.ReadAll(NewDecoder(StdEncoding, strings.NewReader(EncodeToString(src))))
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(src, decoded), "size %d", size)
+	}
+}
+
+func TestStreamTransforms(t *testing.T) {
+	e := NewStdEncoding().Option(Transforms(ReverseBytes()))
+
+	var buf bytes.Buffer
+	enc := NewEncoder(e, &buf)
+	_, err := enc.Write([]byte{1, 2, 3})
+	assert.IsType(t, ErrInvalidConfig{}, err)
+	assert.IsType(t, ErrInvalidConfig{}, enc.Close())
+	assert.Equal(t, 0, buf.Len())
+
+	_, err = io.ReadAll(NewDecoder(e, strings.NewReader(e.EncodeToString([]byte{1, 2, 3}))))
+	assert.IsType(t, ErrInvalidConfig{}, err)
+}
@@ -0,0 +1,26 @@
+package base62
+
+import (
+	"fmt"
+)
+
+// Strict sets decoding to accept only the canonical encoding of each value,
+// exactly as the encoding would produce it, so that every value has a
+// single accepted representation for use as cache or deduplication keys.
+// Redundant leading zeros beyond the padding, missing padding and any
+// separators, case or characters the encoding would not produce are
+// rejected with an ErrNotCanonical
+func Strict() option {
+	return func(e *Encoding) {
+		e.strict = true
+	}
+}
+
+// canonical returns an ErrNotCanonical if s differs from the canonical
+// encoding of its value
+func (e *Encoding) canonical(s, canonical string) error {
+	if s != canonical {
+		return ErrNotCanonical{fmt.Errorf("Encoding %q is not canonical, expected %q", s, canonical)}
+	}
+	return nil
+}
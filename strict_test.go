@@ -0,0 +1,55 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrict(t *testing.T) {
+	e := NewStdEncoding().Option(Strict())
+
+	for _, tc := range testcases {
+		n, err := e.DecodeToInt64(tc.encoded)
+		require.NoError(t, err, tc.encoded)
+		assert.Equal(t, tc.num, n)
+	}
+
+	for _, s := range []string{"05Frvgk", "00"} {
+		_, err := e.DecodeToInt64(s)
+		t.Logf("Decoding %q failed with %v", s, err)
+		assert.IsType(t, ErrNotCanonical{}, err, s)
+
+		_, err = e.DecodeToUint64(s)
+		assert.IsType(t, ErrNotCanonical{}, err, s)
+
+		_, ok := e.TryDecodeToInt64(s)
+		assert.False(t, ok, s)
+	}
+
+	_, err := e.DecodeToInt64("")
+	assert.IsType(t, ErrEmptyInput{}, err)
+
+	// Invalid encodings report their original error
+	_, err = e.DecodeToInt64("5Frv-gk")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
+
+func TestStrictPadded(t *testing.T) {
+	e := NewStdEncoding().Option(Strict(), Padding(8), Group(4, "-"))
+
+	n, err := e.DecodeToInt64("005F-rvgk")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4815162342), n)
+
+	for _, s := range []string{"5Frvgk", "005Frvgk", "0005-Frvgk", "05F-rvgk"} {
+		_, err := e.DecodeToInt64(s)
+		assert.IsType(t, ErrNotCanonical{}, err, s)
+	}
+
+	// Values wider than the padding have no leading zeros
+	n, err = e.DecodeToInt64("AzL8-n0Y5-8m7")
+	require.NoError(t, err)
+	assert.Equal(t, int64(9223372036854775807), n)
+}
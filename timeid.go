@@ -0,0 +1,64 @@
+package base62
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"time"
+)
+
+// TimeIDPrefixLen is the width of the millisecond timestamp prefix of time
+// prefixed IDs, enough for several thousand years from the Unix epoch
+const TimeIDPrefixLen = 8
+
+// NewTimeID returns a time prefixed ID using the StdEncoding, see
+// Encoding.NewTimeID
+func NewTimeID(t time.Time, randomLen int) (string, error) {
+	return StdEncoding.NewTimeID(t, randomLen)
+}
+
+// ParseTimeID returns the time of a time prefixed ID using the
+// StdEncoding, see Encoding.ParseTimeID
+func ParseTimeID(s string) (time.Time, error) {
+	return StdEncoding.ParseTimeID(s)
+}
+
+// NewTimeID returns an ID of the Unix millisecond timestamp of t encoded to
+// a fixed width of TimeIDPrefixLen characters, followed by randomLen
+// random characters as from RandomString. IDs therefore sort by time, and
+// cluster by insertion time in B-tree indexes, while remaining unguessable
+func (e *Encoding) NewTimeID(t time.Time, randomLen int) (string, error) {
+	return e.newTimeID(rand.Reader, t, randomLen)
+}
+
+// newTimeID returns a time prefixed ID with random characters from r
+func (e *Encoding) newTimeID(r io.Reader, t time.Time, randomLen int) (string, error) {
+	ms := t.UnixMilli()
+	if ms < 0 {
+		return "", ErrOverflow{fmt.Errorf("Time %v is before the Unix epoch", t)}
+	}
+
+	suffix, err := e.randomString(r, randomLen)
+	if err != nil {
+		return "", err
+	}
+
+	return e.pad(e.encodeUint64(uint64(ms)), TimeIDPrefixLen) + suffix, nil
+}
+
+// ParseTimeID returns the time, to millisecond precision, at which an ID
+// returned by NewTimeID was created
+func (e *Encoding) ParseTimeID(s string) (time.Time, error) {
+	if len(s) < TimeIDPrefixLen {
+		return time.Time{}, ErrInvalidLength{fmt.Errorf("Time ID %s is shorter than its %d character prefix", s, TimeIDPrefixLen)}
+	}
+
+	for i := 0; i < len(s); i++ {
+		if e.index(s[i]) == -1 {
+			return time.Time{}, e.invalidCharacter(s, i)
+		}
+	}
+
+	ms, _, _ := e.parseUint64(s[:TimeIDPrefixLen])
+	return time.UnixMilli(int64(ms)), nil
+}
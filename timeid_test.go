@@ -0,0 +1,51 @@
+package base62
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimeID(t *testing.T) {
+	now := time.UnixMilli(1700000000123)
+
+	s, err := NewTimeID(now, 12)
+	require.NoError(t, err)
+	t.Logf("Time ID %s", s)
+	assert.Len(t, s, TimeIDPrefixLen+12)
+	assert.Equal(t, "0Tvcokih", s[:TimeIDPrefixLen])
+
+	v, err := ParseTimeID(s)
+	require.NoError(t, err)
+	assert.True(t, now.Equal(v))
+
+	// Random suffixes differ
+	o, err := NewTimeID(now, 12)
+	require.NoError(t, err)
+	assert.NotEqual(t, s, o)
+}
+
+func TestTimeIDSortOrder(t *testing.T) {
+	var ids []string
+	for i := 0; i < 100; i++ {
+		s, err := NewTimeID(time.UnixMilli(int64(i)*999983), 4)
+		require.NoError(t, err)
+		ids = append(ids, s)
+	}
+
+	assert.True(t, sort.StringsAreSorted(ids))
+}
+
+func TestTimeIDInvalid(t *testing.T) {
+	_, err := NewTimeID(time.UnixMilli(-1), 4)
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = ParseTimeID("0Tvcoki")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = ParseTimeID("0Tvcokih-abc")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+}
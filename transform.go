@@ -0,0 +1,124 @@
+package base62
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+)
+
+// Transform is a reversible stage applied to bytes before they are encoded,
+// and undone after they are decoded, such as for simple obfuscation schemes
+type Transform struct {
+	// Encode transforms bytes before encoding, it must not modify its input
+	Encode func(b []byte) []byte
+
+	// Decode reverses Encode after decoding, returning an error if the
+	// bytes could not have been produced by Encode
+	Decode func(b []byte) ([]byte, error)
+}
+
+// pipeline is the sequence of transforms of an encoding
+type pipeline struct {
+	stages []Transform
+}
+
+// Transforms adds stages to the transform pipeline of the encoding, which
+// are applied in order to bytes before they are encoded by EncodeBytes and
+// in reverse order after they are decoded by DecodeBytes
+func Transforms(stages ...Transform) option {
+	return func(e *Encoding) {
+		// Copy the stages so the pipeline of any clone is unaffected
+		p := &pipeline{}
+		if e.transforms != nil {
+			p.stages = append(p.stages, e.transforms.stages...)
+		}
+		p.stages = append(p.stages, stages...)
+		e.transforms = p
+	}
+}
+
+// encode applies each stage of the pipeline in order
+func (p *pipeline) encode(b []byte) []byte {
+	if p == nil {
+		return b
+	}
+
+	for _, t := range p.stages {
+		b = t.Encode(b)
+	}
+	return b
+}
+
+// decode reverses each stage of the pipeline in reverse order
+func (p *pipeline) decode(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+
+	for i := len(p.stages) - 1; i >= 0; i-- {
+		var err error
+		if b, err = p.stages[i].Decode(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// ReverseBytes returns a Transform which reverses the order of the bytes
+func ReverseBytes() Transform {
+	reverse := func(b []byte) []byte {
+		r := slices.Clone(b)
+		slices.Reverse(r)
+		return r
+	}
+
+	return Transform{
+		Encode: reverse,
+		Decode: func(b []byte) ([]byte, error) {
+			return reverse(b), nil
+		},
+	}
+}
+
+// XORKey returns a Transform which XORs the bytes with key, repeating the
+// key as needed. This obscures values but is not encryption. It panics if
+// the key is empty
+func XORKey(key []byte) Transform {
+	if len(key) == 0 {
+		panic("base62: XOR key must not be empty")
+	}
+	key = slices.Clone(key)
+
+	xor := func(b []byte) []byte {
+		r := make([]byte, len(b))
+		for i, v := range b {
+			r[i] = v ^ key[i%len(key)]
+		}
+		return r
+	}
+
+	return Transform{
+		Encode: xor,
+		Decode: func(b []byte) ([]byte, error) {
+			return xor(b), nil
+		},
+	}
+}
+
+// PrefixBytes returns a Transform which prefixes the bytes with p, which
+// must be present when decoding
+func PrefixBytes(p []byte) Transform {
+	p = slices.Clone(p)
+
+	return Transform{
+		Encode: func(b []byte) []byte {
+			return append(slices.Clone(p), b...)
+		},
+		Decode: func(b []byte) ([]byte, error) {
+			if !bytes.HasPrefix(b, p) {
+				return nil, ErrTransform{fmt.Errorf("Decoded bytes are missing prefix %x", p)}
+			}
+			return b[len(p):], nil
+		},
+	}
+}
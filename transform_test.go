@@ -0,0 +1,65 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransforms(t *testing.T) {
+	b := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	testCases := []struct {
+		stages  []Transform
+		encoded string
+	}{
+		{nil, "44pZgF"},
+		{[]Transform{ReverseBytes()}, "4OCxDi"},
+		{[]Transform{XORKey([]byte{0xff})}, "0bpfVo"},
+		{[]Transform{PrefixBytes([]byte{1})}, "08lUosJ"},
+		{[]Transform{ReverseBytes(), XORKey([]byte{1, 2}), PrefixBytes([]byte{1})}, "093jFjI"},
+	}
+
+	for _, tc := range testCases {
+		e := NewStdEncoding().Option(Transforms(tc.stages...))
+
+		s := e.EncodeBytes(b)
+		t.Logf("Encoded %x as %s with %d transforms", b, s, len(tc.stages))
+		assert.Equal(t, tc.encoded, s)
+
+		v, err := e.DecodeBytes(s)
+		require.NoError(t, err)
+		assert.Equal(t, b, v)
+
+		a, err := DecodeArrayWith[[4]byte](e, s)
+		require.NoError(t, err)
+		assert.Equal(t, [4]byte(b), a)
+	}
+
+	// The input is never modified
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, b)
+}
+
+func TestTransformsClone(t *testing.T) {
+	e := NewStdEncoding().Option(Transforms(ReverseBytes()))
+	c := e.Clone()
+	assert.True(t, e.Equal(c))
+
+	c.Option(Transforms(XORKey([]byte{0xff})))
+	assert.False(t, e.Equal(c))
+	assert.Equal(t, 1, len(e.transforms.stages))
+	assert.Equal(t, 2, len(c.transforms.stages))
+}
+
+func TestXORKeyEmpty(t *testing.T) {
+	assert.Panics(t, func() { XORKey(nil) })
+	assert.Panics(t, func() { XORKey([]byte{}) })
+}
+
+func TestPrefixBytesMissing(t *testing.T) {
+	e := NewStdEncoding().Option(Transforms(PrefixBytes([]byte{1})))
+
+	_, err := e.DecodeBytes(EncodeBytes([]byte{2, 0xde}))
+	assert.IsType(t, ErrTransform{}, err)
+}
@@ -0,0 +1,94 @@
+package base62
+
+import (
+	"strings"
+	"unicode"
+)
+
+// confusables are groups of characters which are commonly mistaken for one
+// another when transcribed by people, most likely intended character first
+var confusables = []string{
+	"0OoQD",
+	"1lI|i!",
+	"2Zz",
+	"5Ss",
+	"6Gb",
+	"8B",
+	"9gq",
+	"UuVv",
+}
+
+// homoglyphs map visually identical characters from other scripts
+// onto their ASCII equivalents
+var homoglyphs = map[rune]rune{
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O',
+	'Р': 'P', 'С': 'C', 'Т': 'T', 'Х': 'X', 'а': 'a', 'е': 'e', 'о': 'o',
+	'р': 'p', 'с': 'c', 'у': 'y', 'х': 'x', 'Α': 'A', 'Β': 'B', 'Ε': 'E',
+	'Ζ': 'Z', 'Η': 'H', 'Ι': 'I', 'Κ': 'K', 'Μ': 'M', 'Ν': 'N', 'Ο': 'O',
+	'Ρ': 'P', 'Τ': 'T', 'Χ': 'X', 'Υ': 'Y', 'ο': 'o',
+}
+
+// candidates returns the characters of the alphabet which r may have been
+// mistyped for, most likely first
+func (e *Encoding) candidates(r rune) []rune {
+	var (
+		c    []rune
+		orig = r
+	)
+	add := func(v rune) {
+		if v != orig && strings.ContainsRune(e.encode, v) && !containsRune(c, v) {
+			c = append(c, v)
+		}
+	}
+
+	// Fold fullwidth forms and homoglyphs from other scripts onto ASCII
+	if r >= '！' && r <= '～' {
+		r = r - '！' + '!'
+		add(r)
+	}
+	if v, ok := homoglyphs[r]; ok {
+		r = v
+		add(r)
+	}
+
+	// The same letter in the other case
+	add(unicode.ToUpper(r))
+	add(unicode.ToLower(r))
+
+	for _, group := range confusables {
+		if strings.ContainsRune(group, r) {
+			for _, v := range group {
+				add(v)
+			}
+		}
+	}
+
+	return c
+}
+
+// suggest returns s with every character outside of the alphabet replaced by
+// its most likely candidate, or an empty string if any character has none
+func (e *Encoding) suggest(s string) string {
+	var b strings.Builder
+	for _, v := range s {
+		if !strings.ContainsRune(e.encode, v) {
+			c := e.candidates(v)
+			if len(c) == 0 {
+				return ""
+			}
+			v = c[0]
+		}
+		b.WriteRune(v)
+	}
+
+	return b.String()
+}
+
+func containsRune(runes []rune, r rune) bool {
+	for _, v := range runes {
+		if v == r {
+			return true
+		}
+	}
+	return false
+}
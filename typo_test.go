@@ -0,0 +1,54 @@
+package base62
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A Crockford style alphabet would exclude confusable characters, but
+// needs 62 of them, so drop the ones we test and pad with symbols
+const typoAlphabet = "0123456789ABCDEFGHJKMNPQRTUVWXYZabcdefghjkmnprstuvwxyz-_.~+=*!"
+
+func TestInvalidCharacterSuggestion(t *testing.T) {
+	e := NewEncoding(typoAlphabet, Sign('^'))
+
+	testcases := []struct {
+		s          string
+		char       rune
+		offset     int
+		candidates []rune
+		suggestion string
+	}{
+		{"5FrvO", 'O', 4, []rune{'0', 'Q', 'D'}, "5Frv0"},
+		{"lA", 'l', 0, []rune{'1', '!'}, "1A"},
+		{"AIo", 'I', 1, []rune{'1', '!'}, "A10"},
+		{"12S", 'S', 2, []rune{'s', '5'}, "12s"},
+		{"１２", '１', 0, []rune{'1', '!'}, "12"},
+		{"ВА", 'В', 0, []rune{'B', 'b', '8'}, "BA"},
+		{"A B", ' ', 1, nil, ""},
+		{"IO L", 'I', 0, []rune{'1', '!'}, ""},
+	}
+
+	for _, tc := range testcases {
+		_, err := e.DecodeToInt64(tc.s)
+		t.Logf("Decoding %q failed with %v", tc.s, err)
+
+		var invalid ErrInvalidCharacter
+		if assert.True(t, errors.As(err, &invalid)) {
+			assert.Equal(t, tc.char, invalid.Char)
+			assert.Equal(t, tc.offset, invalid.Offset)
+			assert.Equal(t, tc.candidates, invalid.Candidates)
+			assert.Equal(t, tc.suggestion, invalid.Suggestion)
+		}
+	}
+}
+
+func TestInvalidCharacterMessage(t *testing.T) {
+	_, err := DecodeToInt64("5Frv|gk")
+	assert.EqualError(t, err, "Invalid character | at 4, did you mean 5Frv1gk?")
+
+	_, err = DecodeToInt64("5Frv-gk")
+	assert.EqualError(t, err, "Invalid character - at 4")
+}
@@ -0,0 +1,92 @@
+package base62
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ULID is a 128 bit time ordered identifier, of a 48 bit millisecond Unix
+// timestamp followed by 80 random bits, as in the ULID specification.
+// Encoded to a fixed width with the standard alphabet and byte order, the
+// string order of ULIDs is their order of creation
+type ULID [16]byte
+
+// maxULIDTime is the greatest millisecond timestamp of a ULID
+const maxULIDTime = 1<<48 - 1
+
+// NewULID returns a ULID for time t, with random bits read from entropy,
+// or crypto/rand if entropy is nil. An ErrOverflow is returned if t is
+// before the Unix epoch or beyond the range of 48 bits of milliseconds
+func NewULID(t time.Time, entropy io.Reader) (ULID, error) {
+	var u ULID
+
+	ms := t.UnixMilli()
+	if ms < 0 || ms > maxULIDTime {
+		return u, ErrOverflow{fmt.Errorf("Time %v is outside of the range of a ULID", t)}
+	}
+
+	if entropy == nil {
+		entropy = rand.Reader
+	}
+	if _, err := io.ReadFull(entropy, u[6:]); err != nil {
+		return u, err
+	}
+
+	// The timestamp is the 48 most significant bits, big-endian
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(ms))
+	copy(u[:6], ts[2:])
+
+	return u, nil
+}
+
+// Time returns the timestamp of the ULID, to millisecond precision
+func (u ULID) Time() time.Time {
+	var ts [8]byte
+	copy(ts[2:], u[:6])
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(ts[:])))
+}
+
+// EncodeULID returns the 22 character encoding of a ULID using the
+// StdEncoding, see Encoding.EncodeULID
+func EncodeULID(u ULID) string {
+	return StdEncoding.EncodeULID(u)
+}
+
+// ParseULID decodes a ULID using the StdEncoding, see Encoding.ParseULID
+func ParseULID(s string) (ULID, error) {
+	return StdEncoding.ParseULID(s)
+}
+
+// EncodeULID returns the fixed width 22 character encoding of a ULID, as
+// with EncodeUUID. Other Codecs encode ULIDs with EncodeArrayWith
+func (e *Encoding) EncodeULID(u ULID) string {
+	return e.EncodeUUID(u)
+}
+
+// ParseULID decodes a ULID encoded by EncodeULID, from which the creation
+// time may be extracted with Time
+func (e *Encoding) ParseULID(s string) (ULID, error) {
+	u, err := e.DecodeUUID(s)
+	return ULID(u), err
+}
+
+// Scan implements fmt.Scanner, reading a ULID encoded with the StdEncoding
+// by the %v or %s verbs of fmt.Sscanf and the like
+func (u *ULID) Scan(state fmt.ScanState, verb rune) error {
+	s, err := scanToken(state, verb)
+	if err != nil {
+		return err
+	}
+
+	v, err := ParseULID(s)
+	if err != nil {
+		return err
+	}
+
+	*u = v
+	return nil
+}
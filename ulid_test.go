@@ -0,0 +1,82 @@
+package base62
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewULID(t *testing.T) {
+	now := time.UnixMilli(1700000000123)
+
+	u, err := NewULID(now, bytes.NewReader(bytes.Repeat([]byte{0xff}, 10)))
+	require.NoError(t, err)
+	assert.Equal(t, ULID{0x01, 0x8b, 0xcf, 0xe5, 0x68, 0x7b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, u)
+	assert.True(t, now.Equal(u.Time()))
+
+	s := EncodeULID(u)
+	t.Logf("Encoded ULID %x as %s", u, s)
+	assert.Len(t, s, 22)
+
+	v, err := ParseULID(s)
+	require.NoError(t, err)
+	assert.Equal(t, u, v)
+	assert.True(t, now.Equal(v.Time()))
+}
+
+func TestNewULIDRandom(t *testing.T) {
+	a, err := NewULID(time.Now(), nil)
+	require.NoError(t, err)
+	b, err := NewULID(a.Time(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, a.Time(), b.Time())
+	assert.NotEqual(t, a, b)
+}
+
+func TestULIDSortOrder(t *testing.T) {
+	var ids []string
+	for i := 0; i < 100; i++ {
+		u, err := NewULID(time.UnixMilli(int64(i)*7919), nil)
+		require.NoError(t, err)
+		ids = append(ids, EncodeULID(u))
+	}
+
+	assert.True(t, sort.StringsAreSorted(ids))
+}
+
+func TestNewULIDInvalid(t *testing.T) {
+	_, err := NewULID(time.UnixMilli(-1), nil)
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = NewULID(time.UnixMilli(maxULIDTime+1), nil)
+	assert.IsType(t, ErrOverflow{}, err)
+
+	// Short entropy is an error
+	_, err = NewULID(time.Now(), bytes.NewReader([]byte{1, 2, 3}))
+	assert.Error(t, err)
+
+	_, err = ParseULID("tooshort")
+	assert.IsType(t, ErrInvalidLength{}, err)
+}
+
+func TestULIDScan(t *testing.T) {
+	u, err := NewULID(time.UnixMilli(1700000000123), bytes.NewReader(bytes.Repeat([]byte{0xff}, 10)))
+	require.NoError(t, err)
+
+	var v ULID
+	_, err = fmt.Sscanf("order "+EncodeULID(u), "order %v", &v)
+	require.NoError(t, err)
+	assert.Equal(t, u, v)
+
+	_, err = fmt.Sscanf("order 5Frvgk", "order %v", &v)
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = fmt.Sscanf(EncodeULID(u), "%d", &v)
+	assert.IsType(t, ErrInvalidValue{}, err)
+}
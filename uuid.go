@@ -0,0 +1,62 @@
+package base62
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// EncodeUUID returns the fixed width base62 encoding of a UUID using the
+// StdEncoding, see Encoding.EncodeUUID
+func EncodeUUID(src [16]byte) string {
+	return StdEncoding.EncodeUUID(src)
+}
+
+// DecodeUUID decodes a UUID encoded by EncodeUUID using the StdEncoding
+func DecodeUUID(s string) ([16]byte, error) {
+	return StdEncoding.DecodeUUID(s)
+}
+
+// EncodeUUID returns the canonical 22 character base62 encoding of a UUID,
+// padded to a fixed width so short UUIDs in URLs sort and compare as their
+// values do. Transforms aren't applied, as they may change the length of
+// the bytes, use EncodeArrayWith for a UUID to be transformed
+func (e *Encoding) EncodeUUID(src [16]byte) string {
+	var dst [22]byte
+	e.EncodeUUIDTo(&dst, src)
+	return string(dst[:])
+}
+
+// DecodeUUID decodes a UUID encoded by EncodeUUID, which must be exactly
+// 22 characters
+func (e *Encoding) DecodeUUID(s string) ([16]byte, error) {
+	var u [16]byte
+	err := e.decodeFixed(s, u[:])
+	return u, err
+}
+
+// EncodeUUIDTo writes the fixed width base62 encoding of a UUID to dst
+// using the StdEncoding, see Encoding.EncodeUUIDTo
+func EncodeUUIDTo(dst *[22]byte, src [16]byte) {
+	StdEncoding.EncodeUUIDTo(dst, src)
+}
+
+// EncodeUUIDTo writes the fixed width base62 encoding of a UUID to dst
+// without allocating. The output is identical to EncodeArrayWith for
+// encodings without Transforms
+func (e *Encoding) EncodeUUIDTo(dst *[22]byte, src [16]byte) {
+	hi := binary.BigEndian.Uint64(src[:8])
+	lo := binary.BigEndian.Uint64(src[8:])
+	if e.littleEndian {
+		hi = binary.LittleEndian.Uint64(src[8:])
+		lo = binary.LittleEndian.Uint64(src[:8])
+	}
+
+	// Divide the 128 bit value by our base a word at a time,
+	// filling in the digits from least significant upwards
+	var rem uint64
+	for i := len(dst) - 1; i >= 0; i-- {
+		hi, rem = bits.Div64(0, hi, base)
+		lo, rem = bits.Div64(rem, lo, base)
+		dst[i] = e.encode[rem]
+	}
+}
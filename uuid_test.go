@@ -0,0 +1,120 @@
+package base62
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeUUIDTo(t *testing.T) {
+	testcases := []struct {
+		uuid    [16]byte
+		encoded string
+	}{
+		{[16]byte{}, "0000000000000000000000"},
+		{[16]byte{15: 1}, "0000000000000000000001"},
+		{[16]byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "3tX16dB2jpss4tZORYcqo3"},
+		{[16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "7n42DGM5Tflk9n8mt7Fhc7"},
+	}
+
+	var dst [22]byte
+	for _, tc := range testcases {
+		EncodeUUIDTo(&dst, tc.uuid)
+		t.Logf("Encoded %x as %s", tc.uuid, dst)
+		assert.Equal(t, tc.encoded, string(dst[:]))
+	}
+}
+
+func TestEncodeUUIDToMatchesArray(t *testing.T) {
+	var (
+		u   [16]byte
+		dst [22]byte
+	)
+
+	for i := 0; i < 1000; i++ {
+		if _, err := rand.Read(u[:]); err != nil {
+			t.Fatal(err)
+		}
+		EncodeUUIDTo(&dst, u)
+		assert.Equal(t, EncodeArray(u), string(dst[:]))
+	}
+}
+
+func TestEncodeUUIDToAllocs(t *testing.T) {
+	var (
+		u   = [16]byte{0xde, 0xad, 0xbe, 0xef}
+		dst [22]byte
+	)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		EncodeUUIDTo(&dst, u)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
+
+func BenchmarkEncodeUUIDTo(b *testing.B) {
+	var (
+		u   = [16]byte{0xde, 0xad, 0xbe, 0xef, 0xca, 0xfe, 0xba, 0xbe}
+		dst [22]byte
+	)
+
+	for n := 0; n < b.N; n++ {
+		EncodeUUIDTo(&dst, u)
+	}
+	result = string(dst[:])
+}
+
+func TestEncodeUUID(t *testing.T) {
+	u := [16]byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	assert.Equal(t, "3tX16dB2jpss4tZORYcqo3", EncodeUUID(u))
+	assert.Equal(t, "0000000000000000000001", EncodeUUID([16]byte{15: 1}))
+
+	for i := 0; i < 100; i++ {
+		if _, err := rand.Read(u[:]); err != nil {
+			t.Fatal(err)
+		}
+		s := EncodeUUID(u)
+		assert.Len(t, s, 22)
+
+		v, err := DecodeUUID(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, u, v)
+	}
+}
+
+func TestEncodeUUIDTransforms(t *testing.T) {
+	e := NewStdEncoding().Option(Transforms(XORKey([]byte("key"))))
+	u := [16]byte{0xde, 0xad, 0xbe, 0xef, 15: 1}
+
+	// Fixed width UUIDs aren't transformed, unlike byte arrays
+	s := e.EncodeUUID(u)
+	assert.Equal(t, EncodeUUID(u), s)
+	assert.False(t, s == EncodeArrayWith(e, u))
+
+	v, err := e.DecodeUUID(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, u, v)
+
+	v, err = DecodeArrayWith[[16]byte](e, EncodeArrayWith(e, u))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, u, v)
+}
+
+func TestDecodeUUIDInvalid(t *testing.T) {
+	_, err := DecodeUUID("3tX16dB2jpss4tZORYcqo")
+	assert.IsType(t, ErrInvalidLength{}, err)
+
+	_, err = DecodeUUID("3tX16dB2jpss4tZORYcq-3")
+	assert.IsType(t, ErrInvalidCharacter{}, err)
+
+	// Beyond the largest 128 bit value
+	_, err = DecodeUUID("zzzzzzzzzzzzzzzzzzzzzz")
+	assert.IsType(t, ErrOverflow{}, err)
+}
@@ -0,0 +1,106 @@
+package base62
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Validate checks s could be decoded using the StdEncoding, see
+// Encoding.Validate
+func Validate(s string) error {
+	return StdEncoding.Validate(s)
+}
+
+// Validate checks s is within the MaxDecodeLen and, once separators and
+// padding are removed, consists only of characters of the alphabet after
+// an optional leading sign marker where int64 values are signed, without
+// decoding its value. This is a cheap check to reject malformed input
+// early, returning an ErrTooLong, ErrEmptyInput, ErrInvalidCharacter or
+// ErrInvalidLength, where invalid characters are reported at their offset
+// in s. Strings which pass may still fail to decode, such as overflowing
+// the type decoded to or failing a check character
+func (e *Encoding) Validate(s string) error {
+	if err := e.checkValue(s); err != nil {
+		return err
+	}
+
+	v := e.unformat(s)
+	start := e.signLen(v)
+	if start > 0 && len(v) == start {
+		return ErrInvalidLength{fmt.Errorf("Sign %c must be followed by digits", v[0])}
+	}
+	if i := e.invalidAt(v, start); i != -1 {
+		r, _ := utf8.DecodeRuneInString(v[i:])
+		return e.invalidRune(r, formattedOffset(s, v, i), e.suggest(v))
+	}
+
+	return nil
+}
+
+// formattedOffset returns the offset in s of the character at offset i of
+// v, the result of unformat. Unformatting only removes characters, so v
+// is matched against s from the start, where removed characters never
+// precede a kept copy of themselves which would be matched in its place
+func formattedOffset(s, v string, i int) int {
+	j := 0
+	for k := 0; k < i; k++ {
+		for s[j] != v[k] {
+			j++
+		}
+		j++
+	}
+	for s[j] != v[i] {
+		j++
+	}
+	return j
+}
+
+// IsValidString reports whether s passes Validate using the StdEncoding
+func IsValidString(s string) bool {
+	return StdEncoding.IsValidString(s)
+}
+
+// IsValidString reports whether s passes Validate, without allocating an
+// error, for routing or sanitising input. Input which is already free of
+// separators and whitespace is checked without allocating at all
+func (e *Encoding) IsValidString(s string) bool {
+	if e.maxDecodeLen > 0 && len(s) > e.maxDecodeLen || s == "" && !e.emptyZero {
+		return false
+	}
+
+	v := e.unformat(s)
+	start := e.signLen(v)
+	return (start == 0 || len(v) > start) && e.invalidAt(v, start) == -1
+}
+
+// ContainsRune reports whether r is a digit of the StdEncoding
+func ContainsRune(r rune) bool {
+	return StdEncoding.ContainsRune(r)
+}
+
+// ContainsRune reports whether r decodes as a digit of the encoding, which
+// includes the other case of letters if CaseInsensitive, and characters
+// added by Remap, but not separators, padding or the sign
+func (e *Encoding) ContainsRune(r rune) bool {
+	return r >= 0 && r < utf8.RuneSelf && e.index(byte(r)) != -1
+}
+
+// signLen returns the length of a leading sign marker of an unformatted
+// string, one if it starts with the marker of a signed encoding, else zero
+func (e *Encoding) signLen(s string) int {
+	if e.signed() && len(s) > 0 && s[0] == e.marker() {
+		return 1
+	}
+	return 0
+}
+
+// invalidAt returns the offset of the first character of s from start
+// which is not in the alphabet, or -1 if there is none
+func (e *Encoding) invalidAt(s string, start int) int {
+	for i := start; i < len(s); i++ {
+		if e.index(s[i]) == -1 {
+			return i
+		}
+	}
+	return -1
+}
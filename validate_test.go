@@ -0,0 +1,154 @@
+package base62
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		e   *Encoding
+		s   string
+		err error
+	}{
+		{StdEncoding, "5Frvgk", nil},
+		{StdEncoding, "", ErrEmptyInput{}},
+		{StdEncoding, "zzzzzzzzzzzzzzzzzzzz", nil},
+		{StdEncoding, "-5Frvgk", nil},
+		{StdEncoding, "-", ErrInvalidLength{}},
+		{StdEncoding, "5Frv-gk", ErrInvalidCharacter{}},
+		{StdEncoding, "5Frvgk ", ErrInvalidCharacter{}},
+		{StdEncoding, "5Frvgké", ErrInvalidCharacter{}},
+		{NewStdEncoding().Option(Sign('~')), "~5Frvgk", nil},
+		{NewStdEncoding().Option(Sign('~')), "-5Frvgk", ErrInvalidCharacter{}},
+		{NewStdEncoding().Option(ZigZag()), "-5Frvgk", ErrInvalidCharacter{}},
+		{NewStdEncoding().Option(Group(3, "-")), "5Fr-vgk", nil},
+		{NewStdEncoding().Option(Lenient()), " 5Fr vgk\n", nil},
+		{NewStdEncoding().Option(Padding(8), PaddingChar('_')), "__5Frvgk", nil},
+		{NewStdEncoding().Option(MaxDecodeLen(4)), "5Frvgk", ErrTooLong{}},
+	}
+
+	for _, tc := range testCases {
+		err := tc.e.Validate(tc.s)
+		t.Logf("Validated %q: %v", tc.s, err)
+		if tc.err == nil {
+			assert.NoError(t, err)
+			continue
+		}
+		assert.IsType(t, tc.err, err)
+	}
+}
+
+func TestValidateOffset(t *testing.T) {
+	err := StdEncoding.Validate("5Frv-gk")
+	if assert.IsType(t, ErrInvalidCharacter{}, err) {
+		assert.Equal(t, '-', err.(ErrInvalidCharacter).Char)
+		assert.Equal(t, 4, err.(ErrInvalidCharacter).Offset)
+	}
+
+	// Offsets are of the input, before separators and padding are removed
+	testcases := []struct {
+		e      *Encoding
+		s      string
+		offset int
+	}{
+		{NewStdEncoding().Option(Group(2, "-")), "AB-C!", 4},
+		{NewStdEncoding().Option(Group(2, "--")), "AB--C-", 5},
+		{NewStdEncoding().Option(IgnoreSeparators(" _")), "A _B_!", 5},
+		{NewStdEncoding().Option(Lenient()), " -5 F-r!", 7},
+		{NewStdEncoding().Option(Padding(6), PaddingChar('_')), "__5_Fr", 3},
+		{NewStdEncoding().Option(Padding(6), PaddingChar('_'), Align(AlignLeft)), "5_Fr__", 1},
+		{NewStdEncoding().Option(Group(2, "·")), "AB·C!", 5},
+	}
+
+	for _, tc := range testcases {
+		err := tc.e.Validate(tc.s)
+		t.Logf("Validated %q with %v", tc.s, err)
+		if assert.IsType(t, ErrInvalidCharacter{}, err) {
+			assert.Equal(t, tc.offset, err.(ErrInvalidCharacter).Offset, tc.s)
+		}
+	}
+}
+
+func TestValidateDecode(t *testing.T) {
+	encodings := []*Encoding{
+		StdEncoding,
+		NewStdEncoding().Option(Sign('~')),
+		NewStdEncoding().Option(ZigZag()),
+	}
+
+	// Validate accepts exactly the strings which decode, for values in range
+	for _, e := range encodings {
+		for _, s := range []string{"5", "-5", "~5", "-", "~", "5-", "-~5"} {
+			_, decodeErr := e.DecodeToInt64(s)
+			err := e.Validate(s)
+			t.Logf("%v validated %q: %v, decoded: %v", e, s, err, decodeErr)
+			assert.Equal(t, decodeErr == nil, err == nil, "%v %q", e, s)
+			assert.Equal(t, err == nil, e.IsValidString(s), "%v %q", e, s)
+		}
+	}
+}
+
+func TestValidateStd(t *testing.T) {
+	assert.NoError(t, Validate("5Frvgk"))
+	assert.Error(t, Validate("5Frv-gk"))
+}
+
+func TestIsValidString(t *testing.T) {
+	testCases := []struct {
+		e     *Encoding
+		s     string
+		valid bool
+	}{
+		{StdEncoding, "5Frvgk", true},
+		{StdEncoding, "", false},
+		{StdEncoding, "-5Frvgk", true},
+		{StdEncoding, "-", false},
+		{StdEncoding, "5Frv-gk", false},
+		{StdEncoding, "5Frvgké", false},
+		{NewStdEncoding().Option(Group(3, "-")), "5Fr-vgk", true},
+		{NewStdEncoding().Option(MaxDecodeLen(4)), "5Frvgk", false},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.valid, tc.e.IsValidString(tc.s), tc.s)
+		assert.Equal(t, tc.valid, tc.e.Validate(tc.s) == nil, tc.s)
+	}
+
+	assert.True(t, IsValidString("5Frvgk"))
+}
+
+func TestIsValidStringAllocs(t *testing.T) {
+	e := NewStdEncoding().Option(Lenient(), MaxDecodeLen(4))
+	for _, s := range []string{"5Frvgk", "5Frv-gk", "5Frvgké", "-", "5Fr"} {
+		allocs := testing.AllocsPerRun(100, func() {
+			StdEncoding.IsValidString(s)
+			e.IsValidString(s)
+		})
+		assert.Equal(t, float64(0), allocs, s)
+	}
+}
+
+func TestContainsRune(t *testing.T) {
+	testCases := []struct {
+		e        *Encoding
+		r        rune
+		contains bool
+	}{
+		{StdEncoding, '0', true},
+		{StdEncoding, 'z', true},
+		{StdEncoding, '-', false},
+		{StdEncoding, ' ', false},
+		{StdEncoding, 'é', false},
+		{StdEncoding, 0x100 + '0', false},
+		{StdEncoding, -1, false},
+		{NewEncoding(unambiguousAlphabet).Option(Remap(CrockfordRemap)), 'O', true},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.contains, tc.e.ContainsRune(tc.r), "%q", tc.r)
+	}
+
+	assert.True(t, ContainsRune('a'))
+}
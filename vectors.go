@@ -0,0 +1,107 @@
+package base62
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+)
+
+// TestVector is a single input with its expected encoding
+type TestVector struct {
+	// Kind of input, one of int64, bigint or bytes
+	Kind string `json:"kind"`
+
+	// Input as a decimal integer, or hex for bytes
+	Input string `json:"input"`
+
+	// Encoded is the expected encoding of the input
+	Encoded string `json:"encoded"`
+
+	// Note describes the edge case the vector covers
+	Note string `json:"note,omitempty"`
+}
+
+// TestVectors are a canonical set of vectors for an encoding, against which
+// implementations in other languages can verify their compatibility
+type TestVectors struct {
+	Spec     string       `json:"spec"`
+	Alphabet string       `json:"alphabet"`
+	Padding  int          `json:"padding"`
+	Vectors  []TestVector `json:"vectors"`
+}
+
+// WriteTestVectors writes the test vectors of the StdEncoding as JSON to w
+func WriteTestVectors(w io.Writer) error {
+	return StdEncoding.WriteTestVectors(w)
+}
+
+// TestVectors returns a canonical set of test vectors for the encoding,
+// covering integers, big integers and fixed width bytes across their edge
+// cases. Byte vectors use the fixed width encoding of EncodeArray
+func (e *Encoding) TestVectors() TestVectors {
+	v := TestVectors{
+		Alphabet: e.encode,
+		Padding:  e.padding,
+	}
+
+	// Encodings which can't be described by a spec are left without one
+	v.Spec, _ = e.Spec()
+
+	ints := []struct {
+		n    int64
+		note string
+	}{
+		{0, "zero"},
+		{1, "one"},
+		{base - 1, "largest single character"},
+		{base, "smallest two characters"},
+		{base * base, "smallest three characters"},
+		{4815162342, ""},
+		{math.MaxInt32, "max int32"},
+		{math.MaxUint32, "max uint32"},
+		{math.MaxInt64 - 1, ""},
+		{math.MaxInt64, "max int64"},
+	}
+	if e.zigzag {
+		ints = append(ints, []struct {
+			n    int64
+			note string
+		}{
+			{-1, "zig-zag negative one"},
+			{-base, ""},
+			{math.MinInt64, "zig-zag min int64"},
+		}...)
+	}
+	for _, tc := range ints {
+		v.Vectors = append(v.Vectors, TestVector{"int64", strconv.FormatInt(tc.n, 10), e.EncodeInt64(tc.n), tc.note})
+	}
+
+	v.Vectors = append(v.Vectors, e.bigTestVectors()...)
+
+	bytes := []struct {
+		b    []byte
+		note string
+	}{
+		{[]byte{}, "empty"},
+		{[]byte{0}, "single zero byte"},
+		{[]byte{0xff}, "single max byte"},
+		{[]byte{0, 0, 0, 1}, "leading zero bytes"},
+		{[]byte{0xde, 0xad, 0xbe, 0xef}, ""},
+		{make([]byte, 16), "zero UUID"},
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "max UUID"},
+	}
+	for _, tc := range bytes {
+		v.Vectors = append(v.Vectors, TestVector{"bytes", hex.EncodeToString(tc.b), e.encodeFixed(tc.b), tc.note})
+	}
+
+	return v
+}
+
+// WriteTestVectors writes the test vectors of the encoding as JSON to w
+func (e *Encoding) WriteTestVectors(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(e.TestVectors())
+}
@@ -0,0 +1,50 @@
+package base62
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestVectors(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteTestVectors(&buf))
+
+	var v TestVectors
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &v))
+	assert.Equal(t, "std", v.Spec)
+	assert.Equal(t, encodeStd, v.Alphabet)
+
+	kinds := make(map[string]int)
+	for _, tc := range v.Vectors {
+		kinds[tc.Kind]++
+
+		// Verify each vector decodes back to its input
+		switch tc.Kind {
+		case "int64":
+			n, err := strconv.ParseInt(tc.Input, 10, 64)
+			require.NoError(t, err)
+			assert.Equal(t, EncodeInt64(n), tc.Encoded)
+		case "bytes":
+			b, err := hex.DecodeString(tc.Input)
+			require.NoError(t, err)
+			decoded := make([]byte, len(b))
+			require.NoError(t, StdEncoding.decodeFixed(tc.Encoded, decoded))
+			assert.Equal(t, b, decoded)
+		}
+	}
+	assert.NotEmpty(t, kinds["int64"])
+	assert.NotEmpty(t, kinds["bytes"])
+}
+
+func TestTestVectorsEncoding(t *testing.T) {
+	v := NewStdEncoding().Option(Padding(4), ZigZag()).TestVectors()
+	assert.Equal(t, "std;pad=4;zigzag", v.Spec)
+	assert.Equal(t, 4, v.Padding)
+	assert.Contains(t, v.Vectors, TestVector{"int64", "-1", "0001", "zig-zag negative one"})
+}
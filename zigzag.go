@@ -0,0 +1,29 @@
+package base62
+
+// zigzag maps signed integers onto unsigned integers so that values of
+// small magnitude, positive or negative, have small encodings
+func zigzag(n int64) uint64 {
+	return uint64(n<<1) ^ uint64(n>>63)
+}
+
+// unzigzag reverses the zigzag mapping
+func unzigzag(n uint64) int64 {
+	return int64(n>>1) ^ -int64(n&1)
+}
+
+// unit returns the difference between the encoded values of consecutive
+// non-negative integers, which zig-zag encoding spreads out to every other
+// value
+func (e *Encoding) unit() uint64 {
+	if e.zigzag {
+		return 2
+	}
+	return 1
+}
+
+// negative returns whether zig-zag encoded digits represent a negative
+// value, which are mapped onto the odd numbers. As the base is even,
+// only the least significant digit needs inspecting
+func negative(d []byte) bool {
+	return len(d) > 0 && d[len(d)-1]%2 == 1
+}
@@ -0,0 +1,123 @@
+package base62
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var zigzagTestcases = []struct {
+	num     int64
+	encoded string
+}{
+	{0, "0"},
+	{-1, "1"},
+	{1, "2"},
+	{-2, "3"},
+	{2, "4"},
+	{30, "y"},
+	{-31, "z"},
+	{31, "10"},
+	{-4815162342, "AVjrNT"},
+	{4815162342, "AVjrNU"},
+	{math.MaxInt64, "LygHa16AHYE"},
+	{math.MinInt64, "LygHa16AHYF"},
+}
+
+func TestZigZagEncodeInt64(t *testing.T) {
+	e := NewStdEncoding().Option(ZigZag())
+
+	for _, tc := range zigzagTestcases {
+		v := e.EncodeInt64(tc.num)
+		t.Logf("Encoded %v as %s", tc.num, v)
+		assert.Equal(t, tc.encoded, v)
+	}
+}
+
+func TestZigZagDecodeToInt64(t *testing.T) {
+	e := NewStdEncoding().Option(ZigZag())
+
+	for _, tc := range zigzagTestcases {
+		v, err := e.DecodeToInt64(tc.encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("Decoded %s to %v", tc.encoded, v)
+		assert.Equal(t, tc.num, v)
+	}
+}
+
+func TestZigZagDecodeOverflow(t *testing.T) {
+	e := NewStdEncoding().Option(ZigZag())
+
+	_, err := e.DecodeToInt64("LygHa16AHYG")
+	assert.IsType(t, ErrOverflow{}, err)
+}
+
+func TestZigZagCompare(t *testing.T) {
+	e := NewStdEncoding().Option(ZigZag())
+
+	for _, a := range zigzagTestcases {
+		for _, b := range zigzagTestcases {
+			v, err := e.Compare(a.encoded, b.encoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := 0
+			if a.num < b.num {
+				expected = -1
+			} else if a.num > b.num {
+				expected = 1
+			}
+			assert.Equal(t, expected, v, "%d <=> %d", a.num, b.num)
+		}
+	}
+}
+
+func TestZigZagArithmetic(t *testing.T) {
+	e := NewStdEncoding().Option(ZigZag())
+
+	v, err := e.Next("3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "1", v)
+
+	v, err = e.Add("1", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "2", v)
+
+	v, err = e.Sub("0002", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "0003", v)
+
+	_, err = e.Next("LygHa16AHYE")
+	assert.IsType(t, ErrOverflow{}, err)
+
+	_, err = e.Sub("LygHa16AHYF", 1)
+	assert.IsType(t, ErrOverflow{}, err)
+}
+
+func TestZigZagCounterAndRange(t *testing.T) {
+	e := NewStdEncoding().Option(ZigZag())
+
+	c := e.NewCounter(29)
+	for i := int64(30); i < 100; i++ {
+		n, s := c.Next()
+		assert.Equal(t, i, n)
+		assert.Equal(t, e.EncodeInt64(i), s)
+	}
+
+	i := int64(29)
+	for s := range e.RangeStep(29, 100, 7) {
+		assert.Equal(t, e.EncodeInt64(i), s)
+		i += 7
+	}
+	assert.Equal(t, int64(106), i)
+}